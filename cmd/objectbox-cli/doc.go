@@ -0,0 +1,38 @@
+/*
+Inspects an ObjectBox database from the command line, without the need for the generated binding code
+
+The database is opened using the model information file (objectbox-model.json) the generator keeps next to the entities:
+
+	objectbox-cli [flags] command [arguments]
+
+Commands:
+
+	entities
+		list all entities in the model together with their object count
+	count Entity
+		print the number of objects of the given entity
+	get Entity id
+		print a single object as JSON
+	dump Entity [-format json|ndjson|csv]
+		print all objects of the given entity, by default as a JSON array
+	query Entity "expression" [-format json|ndjson|csv]
+		print objects matching the query, e.g. "Name = 'John' AND Age > 30"
+
+Query expressions use the same syntax as Box.QueryString(), e.g. comparisons combined using AND, OR, NOT and
+parentheses, optionally followed by ORDER BY.
+
+The database directory must contain an existing database, the tool doesn't create a new one.
+Note that the model file is applied to the database, like an application using it would do: it must match the
+database, e.g. the objectbox-model.json the generator created for the application version that last wrote to it.
+Otherwise, the database schema could be changed (e.g. new entities or properties added).
+
+Available flags:
+
+	-dir string
+	      path to the database directory (default "objectbox")
+	-help
+	      print this help
+	-model string
+	      path to the model information file (default "objectbox-model.json")
+*/
+package main
@@ -0,0 +1,276 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+)
+
+func main() {
+	dir, modelFile, command, args := getArgs()
+
+	// the builder would create a new database in a mistyped directory, make sure it's an existing one instead
+	stopOnError(checkDatabase(dir))
+
+	ob, err := objectbox.NewBuilder().Directory(dir).ModelFromJSON(modelFile).BuildOrError()
+	stopOnError(err)
+	defer ob.Close()
+
+	switch command {
+	case "entities":
+		err = listEntities(ob, args)
+	case "count":
+		err = countObjects(ob, args)
+	case "get":
+		err = getObject(ob, args)
+	case "dump":
+		err = dumpObjects(ob, args)
+	case "query":
+		err = queryObjects(ob, args)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %s\n", command)
+		showUsageAndExit()
+	}
+
+	stopOnError(err)
+}
+
+func stopOnError(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
+
+// checkDatabase verifies the directory contains an existing database
+func checkDatabase(dir string) error {
+	if info, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("database directory %s not found: %s", dir, err)
+	} else if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "data.mdb")); err != nil {
+		return fmt.Errorf("database file not found in %s: %s", dir, err)
+	}
+	return nil
+}
+
+func showUsage() {
+	fmt.Fprint(flag.CommandLine.Output(), `Usage:
+	objectbox-cli [flags] command [arguments]
+
+Commands:
+	entities
+		list all entities in the model together with their object count
+	count Entity
+		print the number of objects of the given entity
+	get Entity id
+		print a single object as JSON
+	dump Entity [-format json|ndjson|csv]
+		print all objects of the given entity, by default as a JSON array
+	query Entity "expression" [-format json|ndjson|csv]
+		print objects matching the query, e.g. "Name = 'John' AND Age > 30", see Box.QueryString()
+
+Note: the model file is applied to the database, like an application using it would do, so it must match the
+database, e.g. the objectbox-model.json the generator created for the application version that last wrote to it.
+
+Available flags:
+`)
+	flag.PrintDefaults()
+}
+
+func showUsageAndExit() {
+	showUsage()
+	os.Exit(1)
+}
+
+func getArgs() (dir, modelFile, command string, args []string) {
+	var printHelp bool
+	flag.Usage = showUsage
+	flag.StringVar(&dir, "dir", "objectbox", "path to the database directory")
+	flag.StringVar(&modelFile, "model", "objectbox-model.json", "path to the model information file")
+	flag.BoolVar(&printHelp, "help", false, "print this help")
+	flag.Parse()
+
+	if printHelp {
+		showUsage()
+		os.Exit(0)
+	}
+
+	if flag.NArg() == 0 {
+		showUsageAndExit()
+	}
+
+	return dir, modelFile, flag.Arg(0), flag.Args()[1:]
+}
+
+// commandArgs checks the number of positional arguments and parses the optional format flag following them
+func commandArgs(args []string, count int, withFormat bool) (positional []string, format string, err error) {
+	if len(args) < count {
+		return nil, "", fmt.Errorf("expected %d arguments, %d given", count, len(args))
+	}
+
+	var flags = flag.NewFlagSet("command", flag.ContinueOnError)
+	if withFormat {
+		flags.StringVar(&format, "format", formatJSON, "output format: json, ndjson or csv")
+	}
+
+	if err = flags.Parse(args[count:]); err != nil {
+		return nil, "", err
+	} else if flags.NArg() != 0 {
+		return nil, "", fmt.Errorf("unexpected arguments %v", flags.Args())
+	}
+
+	return args[:count], format, nil
+}
+
+func listEntities(ob *objectbox.ObjectBox, args []string) error {
+	if _, _, err := commandArgs(args, 0, false); err != nil {
+		return err
+	}
+
+	for _, name := range ob.EntityNames() {
+		box, err := ob.DynamicBox(name)
+		if err != nil {
+			return err
+		}
+
+		count, err := box.Count()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\t%d\n", name, count)
+	}
+	return nil
+}
+
+func countObjects(ob *objectbox.ObjectBox, args []string) error {
+	args, _, err := commandArgs(args, 1, false)
+	if err != nil {
+		return err
+	}
+
+	box, err := ob.DynamicBox(args[0])
+	if err != nil {
+		return err
+	}
+
+	count, err := box.Count()
+	if err != nil {
+		return err
+	}
+	fmt.Println(count)
+	return nil
+}
+
+func getObject(ob *objectbox.ObjectBox, args []string) error {
+	args, _, err := commandArgs(args, 2, false)
+	if err != nil {
+		return err
+	}
+
+	box, err := ob.DynamicBox(args[0])
+	if err != nil {
+		return err
+	}
+
+	id, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid ID %s: %s", args[1], err)
+	}
+
+	object, err := box.Get(id)
+	if err != nil {
+		return err
+	} else if object == nil {
+		return fmt.Errorf("object %s %d not found", args[0], id)
+	}
+
+	writer, err := newObjectWriter(os.Stdout, formatJSON, box.PropertyNames())
+	if err != nil {
+		return err
+	}
+	return writer.writeSingle(object)
+}
+
+func dumpObjects(ob *objectbox.ObjectBox, args []string) error {
+	args, format, err := commandArgs(args, 1, true)
+	if err != nil {
+		return err
+	}
+
+	box, err := ob.DynamicBox(args[0])
+	if err != nil {
+		return err
+	}
+
+	writer, err := newObjectWriter(os.Stdout, format, box.PropertyNames())
+	if err != nil {
+		return err
+	}
+
+	// stream the objects instead of reading them all to memory at once
+	err = box.ForEach(func(object interface{}) (bool, error) {
+		return true, writer.write(object.(map[string]interface{}))
+	})
+	if err != nil {
+		return err
+	}
+	return writer.close()
+}
+
+func queryObjects(ob *objectbox.ObjectBox, args []string) error {
+	args, format, err := commandArgs(args, 2, true)
+	if err != nil {
+		return err
+	}
+
+	box, err := ob.DynamicBox(args[0])
+	if err != nil {
+		return err
+	}
+
+	query, err := box.QueryString(args[1])
+	if err != nil {
+		return err
+	}
+	defer query.Close()
+
+	objects, err := query.Find()
+	if err != nil {
+		return err
+	}
+
+	writer, err := newObjectWriter(os.Stdout, format, box.PropertyNames())
+	if err != nil {
+		return err
+	}
+
+	for _, object := range objects.([]map[string]interface{}) {
+		if err = writer.write(object); err != nil {
+			return err
+		}
+	}
+	return writer.close()
+}
@@ -0,0 +1,212 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+const (
+	formatJSON   = "json"
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+)
+
+// objectWriter prints objects in the chosen format, keeping the property order as declared in the model
+type objectWriter struct {
+	format     string
+	properties []string
+	out        *bufio.Writer
+	csv        *csv.Writer
+	count      int
+}
+
+func newObjectWriter(out io.Writer, format string, properties []string) (*objectWriter, error) {
+	var writer = &objectWriter{
+		format:     format,
+		properties: properties,
+		out:        bufio.NewWriter(out),
+	}
+
+	switch format {
+	case formatJSON, formatNDJSON:
+	case formatCSV:
+		writer.csv = csv.NewWriter(writer.out)
+		if err := writer.csv.Write(properties); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %s, expected one of: %s, %s, %s", format, formatJSON, formatNDJSON, formatCSV)
+	}
+
+	return writer, nil
+}
+
+// writeSingle prints a single object on its own (i.e. not as an element of an array) and flushes the output
+func (writer *objectWriter) writeSingle(object map[string]interface{}) error {
+	data, err := writer.marshal(object, "")
+	if err != nil {
+		return err
+	}
+
+	if _, err = writer.out.Write(data); err != nil {
+		return err
+	}
+	if err = writer.out.WriteByte('\n'); err != nil {
+		return err
+	}
+	return writer.out.Flush()
+}
+
+// write prints the next object
+func (writer *objectWriter) write(object map[string]interface{}) error {
+	writer.count++
+
+	switch writer.format {
+	case formatCSV:
+		return writer.csv.Write(writer.csvRecord(object))
+
+	case formatNDJSON:
+		data, err := writer.marshal(object, "")
+		if err != nil {
+			return err
+		}
+		if _, err = writer.out.Write(data); err != nil {
+			return err
+		}
+		return writer.out.WriteByte('\n')
+
+	default:
+		var separator = ",\n  "
+		if writer.count == 1 {
+			separator = "[\n  "
+		}
+		if _, err := writer.out.WriteString(separator); err != nil {
+			return err
+		}
+
+		data, err := writer.marshal(object, "  ")
+		if err != nil {
+			return err
+		}
+		_, err = writer.out.Write(data)
+		return err
+	}
+}
+
+// close finishes the output, e.g. closes the JSON array, and flushes the buffered data
+func (writer *objectWriter) close() error {
+	switch writer.format {
+	case formatCSV:
+		writer.csv.Flush()
+		if err := writer.csv.Error(); err != nil {
+			return err
+		}
+
+	case formatJSON:
+		var end = "\n]\n"
+		if writer.count == 0 {
+			end = "[]\n"
+		}
+		if _, err := writer.out.WriteString(end); err != nil {
+			return err
+		}
+	}
+
+	return writer.out.Flush()
+}
+
+// marshal creates a JSON object with properties in the model order (json.Marshal would sort map keys instead).
+// The result is indented unless it's written as a single line (NDJSON).
+func (writer *objectWriter) marshal(object map[string]interface{}, prefix string) ([]byte, error) {
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for k, name := range writer.properties {
+		if k > 0 {
+			buffer.WriteByte(',')
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(jsonValue(object[name]))
+		if err != nil {
+			return nil, fmt.Errorf("can't encode property %s: %s", name, err)
+		}
+
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+
+	if writer.format == formatNDJSON {
+		return buffer.Bytes(), nil
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, buffer.Bytes(), prefix, "  "); err != nil {
+		return nil, err
+	}
+	return indented.Bytes(), nil
+}
+
+// jsonValue replaces values JSON can't represent: NaN and infinite floats are encoded as strings, e.g. "+Inf"
+func jsonValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case float32:
+		if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return strconv.FormatFloat(float64(value), 'g', -1, 32)
+		}
+	case float64:
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return strconv.FormatFloat(value, 'g', -1, 64)
+		}
+	}
+	return value
+}
+
+// csvRecord formats object values as CSV fields; byte vectors are base64 encoded and string vectors JSON encoded
+func (writer *objectWriter) csvRecord(object map[string]interface{}) []string {
+	var record = make([]string, len(writer.properties))
+	for k, name := range writer.properties {
+		switch value := object[name].(type) {
+		case nil:
+		case string:
+			record[k] = value
+		case []byte:
+			record[k] = base64.StdEncoding.EncodeToString(value)
+		case []string:
+			if data, err := json.Marshal(value); err == nil {
+				record[k] = string(data)
+			}
+		default:
+			record[k] = fmt.Sprint(value)
+		}
+	}
+	return record
+}
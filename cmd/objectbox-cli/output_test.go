@@ -0,0 +1,185 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/test/assert"
+)
+
+func TestObjectWriter(t *testing.T) {
+	var properties = []string{"Id", "Name", "Value", "Tags", "Bytes"}
+	var objects = []map[string]interface{}{
+		{"Id": uint64(1), "Name": "first, \"quoted\"", "Value": 1.5, "Tags": []string{"a", "b"}, "Bytes": []byte{1, 2}},
+		{"Id": uint64(2), "Name": "", "Value": math.NaN(), "Tags": nil, "Bytes": nil},
+		{"Id": uint64(3), "Name": "third", "Value": math.Inf(1), "Tags": []string{}, "Bytes": []byte{}},
+		{"Id": uint64(4), "Name": "fourth", "Value": float32(math.Inf(-1))},
+	}
+
+	var testCases = []struct {
+		format   string
+		expected string
+	}{
+		{formatNDJSON, `{"Id":1,"Name":"first, \"quoted\"","Value":1.5,"Tags":["a","b"],"Bytes":"AQI="}
+{"Id":2,"Name":"","Value":"NaN","Tags":null,"Bytes":null}
+{"Id":3,"Name":"third","Value":"+Inf","Tags":[],"Bytes":""}
+{"Id":4,"Name":"fourth","Value":"-Inf","Tags":null,"Bytes":null}
+`},
+		{formatCSV, `Id,Name,Value,Tags,Bytes
+1,"first, ""quoted""",1.5,"[""a"",""b""]",AQI=
+2,,NaN,,
+3,third,+Inf,[],
+4,fourth,-Inf,,
+`},
+		{formatJSON, `[
+  {
+    "Id": 1,
+    "Name": "first, \"quoted\"",
+    "Value": 1.5,
+    "Tags": [
+      "a",
+      "b"
+    ],
+    "Bytes": "AQI="
+  },
+  {
+    "Id": 2,
+    "Name": "",
+    "Value": "NaN",
+    "Tags": null,
+    "Bytes": null
+  },
+  {
+    "Id": 3,
+    "Name": "third",
+    "Value": "+Inf",
+    "Tags": [],
+    "Bytes": ""
+  },
+  {
+    "Id": 4,
+    "Name": "fourth",
+    "Value": "-Inf",
+    "Tags": null,
+    "Bytes": null
+  }
+]
+`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			var out bytes.Buffer
+			writer, err := newObjectWriter(&out, tc.format, properties)
+			assert.NoErr(t, err)
+			for _, object := range objects {
+				assert.NoErr(t, writer.write(object))
+			}
+			assert.NoErr(t, writer.close())
+			assert.Eq(t, tc.expected, out.String())
+		})
+	}
+
+	_, err := newObjectWriter(&bytes.Buffer{}, "xml", properties)
+	assert.Err(t, err)
+}
+
+func TestObjectWriterEmpty(t *testing.T) {
+	var expected = map[string]string{
+		formatJSON:   "[]\n",
+		formatNDJSON: "",
+		formatCSV:    "Id,Name\n",
+	}
+
+	for format, output := range expected {
+		var out bytes.Buffer
+		writer, err := newObjectWriter(&out, format, []string{"Id", "Name"})
+		assert.NoErr(t, err)
+		assert.NoErr(t, writer.close())
+		assert.Eq(t, output, out.String())
+	}
+}
+
+func TestObjectWriterSingle(t *testing.T) {
+	var out bytes.Buffer
+	writer, err := newObjectWriter(&out, formatJSON, []string{"Id", "Value"})
+	assert.NoErr(t, err)
+	assert.NoErr(t, writer.writeSingle(map[string]interface{}{"Id": uint64(1), "Value": math.NaN()}))
+	assert.Eq(t, "{\n  \"Id\": 1,\n  \"Value\": \"NaN\"\n}\n", out.String())
+}
+
+func TestCommandArgs(t *testing.T) {
+	var testCases = []struct {
+		args       []string
+		count      int
+		withFormat bool
+		positional []string
+		format     string
+		valid      bool
+	}{
+		{nil, 0, false, []string{}, "", true},
+		{[]string{"Entity"}, 1, true, []string{"Entity"}, formatJSON, true},
+		{[]string{"Entity", "-format", "csv"}, 1, true, []string{"Entity"}, formatCSV, true},
+		{[]string{"Entity", "Id > 1", "-format=ndjson"}, 2, true, []string{"Entity", "Id > 1"}, formatNDJSON, true},
+		{[]string{"Entity"}, 2, false, nil, "", false},
+		{[]string{"Entity", "extra"}, 1, false, nil, "", false},
+		{[]string{"Entity", "-format", "csv"}, 1, false, nil, "", false},
+		{[]string{"Entity", "-format"}, 1, true, nil, "", false},
+	}
+
+	for i, tc := range testCases {
+		positional, format, err := commandArgs(tc.args, tc.count, tc.withFormat)
+		if tc.valid {
+			assert.NoErr(t, err)
+			assert.Eq(t, len(tc.positional), len(positional))
+			for k := range positional {
+				assert.Eq(t, tc.positional[k], positional[k])
+			}
+			assert.Eq(t, tc.format, format)
+		} else if err == nil {
+			assert.Failf(t, "case #%d %v: expected an error", i, tc.args)
+		}
+	}
+}
+
+func TestCheckDatabase(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-cli-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	// a missing directory isn't created
+	var missing = filepath.Join(dir, "missing")
+	assert.Err(t, checkDatabase(missing))
+	_, err = os.Stat(missing)
+	assert.True(t, os.IsNotExist(err))
+
+	// neither is a missing database in an existing directory
+	assert.Err(t, checkDatabase(dir))
+
+	var file = filepath.Join(dir, "data.mdb")
+	assert.NoErr(t, ioutil.WriteFile(file, []byte{}, 0600))
+	assert.NoErr(t, checkDatabase(dir))
+
+	// a file instead of a directory
+	assert.Err(t, checkDatabase(file))
+}
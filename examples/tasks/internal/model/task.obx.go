@@ -4,6 +4,7 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
@@ -147,6 +148,13 @@ func BoxForTask(ob *objectbox.ObjectBox) *TaskBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *TaskBox) WithTx(tx *objectbox.Tx) *TaskBox {
+	return &TaskBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the Task.Id property on the passed object will be assigned the new ID as well.
@@ -187,6 +195,12 @@ func (box *TaskBox) PutMany(objects []*Task) ([]uint64, error) {
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *TaskBox) PutManyContext(ctx context.Context, objects []*Task) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -228,11 +242,31 @@ func (box *TaskBox) GetAll() ([]*Task, error) {
 	return objects.([]*Task), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *TaskBox) GetAllContext(ctx context.Context) ([]*Task, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Task), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *TaskBox) Iterate() *TaskIterator {
+	return &TaskIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *TaskBox) Remove(object *Task) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *TaskBox) RemoveContext(ctx context.Context, object *Task) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -246,6 +280,16 @@ func (box *TaskBox) RemoveMany(objects ...*Task) (uint64, error) {
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *TaskBox) RemoveManyContext(ctx context.Context, objects ...*Task) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the Task_ struct to create conditions.
 // Keep the *TaskQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -328,6 +372,30 @@ func (asyncBox *TaskAsyncBox) Remove(object *Task) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// TaskIterator streams stored objects one by one, see TaskBox.Iterate()
+type TaskIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *TaskIterator) Seek(id uint64) *TaskIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *TaskIterator) Reverse() *TaskIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *TaskIterator) ForEach(fn func(*Task) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Task))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all Task which Id is either 42 or 47:
@@ -345,6 +413,58 @@ func (query *TaskQuery) Find() ([]*Task, error) {
 	return objects.([]*Task), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *TaskQuery) FindContext(ctx context.Context) ([]*Task, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Task), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *TaskQuery) FindFirst() (*Task, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Task), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *TaskQuery) FindUnique() (*Task, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Task), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *TaskQuery) Page(size uint64, afterToken string) ([]*Task, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*Task), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *TaskQuery) Filter(fn func(*Task) bool) *TaskQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*Task))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TaskQuery) Offset(offset uint64) *TaskQuery {
 	query.Query.Offset(offset)
@@ -356,3 +476,23 @@ func (query *TaskQuery) Limit(limit uint64) *TaskQuery {
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *TaskQuery) ForEach(fn func(*Task) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*Task))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *TaskQuery) Subscribe(fn func([]*Task, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*Task), nil)
+		}
+	})
+}
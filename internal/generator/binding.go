@@ -35,6 +35,7 @@ type id = uint32
 
 var supportedAnnotations = map[string]bool{
 	"-":         true,
+	"backlink":  true,
 	"converter": true,
 	"date":      true,
 	"id":        true,
@@ -67,6 +68,7 @@ type Entity struct {
 	IdProperty     *Property
 	LastPropertyId modelinfo.IdUid
 	Relations      map[string]*StandaloneRelation
+	Backlinks      []*Backlink
 	Annotations    map[string]*Annotation
 
 	binding          *Binding // parent
@@ -89,6 +91,9 @@ type Property struct {
 	Index       *Index
 	Converter   *string
 
+	// Go type accepted by the generated condition helpers of a property with a converter; empty if not generated
+	ConverterType string
+
 	// type casts for named types
 	CastOnRead  string
 	CastOnWrite string
@@ -96,6 +101,8 @@ type Property struct {
 	Field      *Field // actual code field this property represents
 	entity     *Entity
 	uidRequest bool
+
+	converterFieldType types.Type // type of the field using a converter, see Entity.setConverterTypes()
 }
 
 // Relation contains information about a "to-one" relation
@@ -117,6 +124,18 @@ type StandaloneRelation struct {
 	uidRequest bool
 }
 
+// Backlink contains information about a read-only field listing objects that point to this one using a relation
+type Backlink struct {
+	Name   string
+	Source struct {
+		Name      string
+		IsPointer bool
+	}
+	Field        string // name of the relation field in the source entity
+	Id           id     // ID of the source relation property or the standalone relation
+	IsStandalone bool   // whether the source field is a to-many (standalone) relation, otherwise it's a to-one
+}
+
 // Index holds information for creating an indexed field in DB
 type Index struct {
 	Identifier
@@ -137,7 +156,8 @@ type Field struct {
 	Fields             []*Field  // inner fields, nil if it's a property
 	SimpleRelation     *Relation
 	StandaloneRelation *StandaloneRelation // to-many relation stored as a standalone relation in the model
-	IsLazyLoaded       bool                // only standalone (to-many) relations currently support lazy loading
+	Backlink           *Backlink           // read-only, not persisted
+	IsLazyLoaded       bool                // only standalone (to-many) relations and backlinks support lazy loading
 
 	path   string // relative addressing path for embedded structs
 	parent *Field // when included in parent.Fields[], nil for top-level fields (directly in the entity)
@@ -305,11 +325,37 @@ func (binding *Binding) createEntityFromAst(strct *ast.StructType, name string,
 			entity.IdProperty.Name, entity.IdProperty.GoType, entity.Name)
 	}
 
+	entity.setConverterTypes()
+
 	binding.Entities = append(binding.Entities, entity)
 
 	return nil
 }
 
+// setConverterTypes decides which properties with a converter get condition helpers accepting the field's Go type
+// and adds the imports necessary to reference the type in the generated code
+func (entity *Entity) setConverterTypes() {
+	for _, property := range entity.Properties {
+		if property.converterFieldType == nil || property == entity.IdProperty || property.IsPointer ||
+			property.Relation != nil || property.ConverterValueKind() == "" {
+			continue
+		}
+
+		property.ConverterType = types.TypeString(property.converterFieldType, func(pkg *types.Package) string {
+			if pkg.Path() == entity.binding.Package.Path() {
+				return ""
+			}
+
+			if pkg.Name() == path.Base(pkg.Path()) {
+				entity.binding.Imports[pkg.Path()] = pkg.Path()
+			} else {
+				entity.binding.Imports[pkg.Name()] = pkg.Path()
+			}
+			return pkg.Name()
+		})
+	}
+}
+
 func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, prefix string, recursionStack *map[string]bool) ([]*Field, error) {
 	var propertyLog = func(text string, property *Property) {
 		log.Printf("%s property %s found in %s", text, property.Name, fieldPath)
@@ -397,6 +443,15 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 				return nil, propertyError(err, property)
 			}
 
+		} else if property.Annotations["backlink"] != nil {
+			if err := field.processBacklink(f); err != nil {
+				return nil, propertyError(err, property)
+			}
+
+			// backlinks are not persisted in DB so skip adding the property
+			field.Property = nil
+			continue
+
 		} else if innerStructFields, err := field.processType(f); err != nil {
 			return nil, propertyError(err, property)
 
@@ -478,6 +533,11 @@ func (entity *Entity) addFields(parent *Field, fields fieldList, fieldPath, pref
 			entity.binding.Imports["errors"] = "errors"
 		}
 
+		// remember the field type to generate condition helpers accepting it, see Entity.setConverterTypes()
+		if property.Converter != nil {
+			property.converterFieldType = fieldType(f)
+		}
+
 		// if this is an ID, set it as entity.IdProperty
 		if property.Annotations["id"] != nil {
 			if entity.IdProperty != nil {
@@ -632,6 +692,52 @@ func (field *Field) processType(f field) (fields fieldList, err error) {
 	return nil, fmt.Errorf("unknown type %s", typ.String())
 }
 
+// processBacklink configures a field with the `backlink` annotation, i.e. a slice of objects pointing to this one.
+// Whether it's based on a to-one or a to-many relation is resolved later, when merging with the model.
+func (field *Field) processBacklink(f field) error {
+	var property = field.Property
+
+	if len(property.Annotations) != 1 {
+		return errors.New("backlink annotation can't be combined with other annotations")
+	}
+
+	var backlink = &Backlink{Name: field.Name, Field: property.Annotations["backlink"].Value}
+	if len(backlink.Field) == 0 {
+		return errors.New("backlink annotation value must not be empty - it's the relation field name in the source entity")
+	}
+
+	var typ = f.Type()
+	baseType, err := typ.UnderlyingOrError()
+	if err != nil {
+		return err
+	}
+
+	slice, isSlice := baseType.(*types.Slice)
+	if !isSlice {
+		return fmt.Errorf("backlink field must be a slice of source entity objects, got %s", typ.String())
+	}
+
+	var elementType = slice.Elem()
+	backlink.Source.Name = typeBaseName(elementType.String())
+	if _, isPointer := elementType.(*types.Pointer); isPointer {
+		backlink.Source.IsPointer = true
+	}
+
+	// fill in the field information
+	field.fillInfo(f, typesTypeErrorful{elementType})
+	if backlink.Source.IsPointer {
+		field.Type = "[]*" + field.Type
+	} else {
+		field.Type = "[]" + field.Type
+	}
+
+	// backlinks are always lazy loaded - the source entity usually links back to this one, causing an endless recursion
+	field.IsLazyLoaded = true
+	field.Backlink = backlink
+	field.Entity.Backlinks = append(field.Entity.Backlinks, backlink)
+	return nil
+}
+
 func (field *Field) fillInfo(f field, typ typeErrorful) {
 	if namedType, isNamed := f.TypeInternal().(*types.Named); isNamed {
 		field.Type = namedType.Obj().Name()
@@ -1137,6 +1243,23 @@ func (property *Property) Path() string {
 	return property.Field.Path()
 }
 
+// ConverterValueKind returns the kind of the database value used by the condition helpers of a property with
+// a converter: "int", "float", "string" or "bytes"; an empty string if the helpers aren't supported for the type.
+// Called from the template.
+func (property *Property) ConverterValueKind() string {
+	switch property.GoType {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "rune", "byte":
+		return "int"
+	case "float32", "float64":
+		return "float"
+	case "string":
+		return "string"
+	case "[]byte":
+		return "bytes"
+	}
+	return ""
+}
+
 // AnnotatedType returns "type" annotation value
 func (property *Property) AnnotatedType() string {
 	return property.Annotations["type"].Value
@@ -1197,6 +1320,19 @@ func (property *Property) TplSetAndReturn(objVar, castType, rhs string) string {
 return ` + ret
 }
 
+// fieldType returns the type-checked type of the field or nil if it can't be resolved
+func fieldType(f field) types.Type {
+	var typ = f.TypeInternal()
+	if expr, isAst := typ.(astTypeExpr); isAst {
+		resolved, err := expr.source.getType(expr.Expr)
+		if err != nil {
+			return nil
+		}
+		return resolved
+	}
+	return typ
+}
+
 func typeBaseName(name string) string {
 	// strip the '*' if it's a pointer type
 	name = strings.TrimPrefix(name, "*")
@@ -19,6 +19,7 @@ package generator
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/objectbox/objectbox-go/internal/generator/modelinfo"
 )
@@ -40,6 +41,13 @@ func mergeBindingWithModelInfo(binding *Binding, modelInfo *modelinfo.ModelInfo)
 		}
 	}
 
+	// backlinks can only be resolved after all entities (and their relations) are in the model
+	for k, bindingEntity := range binding.Entities {
+		if err := mergeBacklinks(bindingEntity.Fields, models[k], modelInfo); err != nil {
+			return err
+		}
+	}
+
 	// NOTE this is not ideal as there could be models across multiple packages
 	modelInfo.Package = binding.Package.Name()
 
@@ -307,3 +315,41 @@ func bindingRelationExists(modelRelation *modelinfo.StandaloneRelation, bindingE
 
 	return false
 }
+
+// mergeBacklinks finds the relation each backlink is based on and checks it points to the entity declaring the backlink
+func mergeBacklinks(fields []*Field, modelEntity *modelinfo.Entity, modelInfo *modelinfo.ModelInfo) error {
+	for _, field := range fields {
+		if field.Backlink == nil {
+			// recursively visit fields in embedded structs
+			if err := mergeBacklinks(field.Fields, modelEntity, modelInfo); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var backlink = field.Backlink
+		sourceEntity, err := modelInfo.FindEntityByName(backlink.Source.Name)
+		if err != nil {
+			return fmt.Errorf("backlink source %s on field %s, entity %s: %s", backlink.Source.Name, field.Name, modelEntity.Name, err)
+		}
+
+		if property, _ := sourceEntity.FindPropertyByName(backlink.Field); property != nil &&
+			strings.ToLower(property.RelationTarget) == strings.ToLower(modelEntity.Name) {
+			backlink.IsStandalone = false
+			if backlink.Id, err = property.Id.GetId(); err != nil {
+				return err
+			}
+		} else if relation, _ := sourceEntity.FindRelationByName(backlink.Field); relation != nil &&
+			relation.TargetId == modelEntity.Id {
+			backlink.IsStandalone = true
+			if backlink.Id, err = relation.Id.GetId(); err != nil {
+				return err
+			}
+		} else {
+			return fmt.Errorf("%s.%s is not a relation to %s, referenced by backlink %s.%s",
+				backlink.Source.Name, backlink.Field, modelEntity.Name, modelEntity.Name, field.Name)
+		}
+	}
+
+	return nil
+}
@@ -38,6 +38,26 @@ var BindingTemplate = template.Must(template.New("binding").Funcs(funcMap).Parse
 	{{- if .CastOnWrite}}){{end}}
 {{- end -}}
 
+{{define "object-ids"}}{{/* used in RemoveMany*/}}
+	var ids = make([]uint64, len(objects))
+	{{- if .IdProperty.Converter}}
+	var err error{{end}}
+	for k, object := range objects {
+		{{if .IdProperty.Converter -}}
+			ids[k], err = {{.IdProperty.TplReadValue "object" ""}}
+			if err != nil {
+				return 0, errors.New("converter {{.IdProperty.Converter}}ToDatabaseValue() failed on {{.Name}}.{{.IdProperty.Path}}: " + err.Error())
+			}
+		{{else -}}
+			ids[k] = {{with .IdProperty -}}
+				{{- if not (eq .GoType "uint64")}} uint64( {{end -}}
+				object.{{.Path}}
+				{{- if not (eq .GoType "uint64")}} ) {{end -}}
+			{{- end}}
+		{{end -}}
+	}
+{{- end -}}
+
 {{define "property-access"}}{{/* used in Flatten*/ -}}
 	{{- if .Converter}} {{if .IsPointer}}*{{end}}prop{{.Name}}
 	{{- else if .CastOnRead}}{{.CastOnRead}}({{if .IsPointer}}*{{end}}obj.{{.Path}})
@@ -48,6 +68,7 @@ var BindingTemplate = template.Must(template.New("binding").Funcs(funcMap).Parse
 package {{.Binding.Package.Name}}
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
@@ -74,14 +95,18 @@ var {{$entity.Name}}Binding = {{$entityNameCamel}}_EntityInfo {
 // {{$entity.Name}}_ contains type-based Property helpers to facilitate some common operations such as Queries. 
 var {{$entity.Name}}_ = struct {
 	{{range $property := $entity.Properties -}}
-    	{{$property.Name}} *objectbox.{{with $property.Relation}}RelationToOne{{else}}Property{{$property.GoType | TypeIdentifier}}{{end}}
+    	{{$property.Name}} *{{if $property.ConverterType}}{{$entity.Name}}{{$property.Name}}Property{{else}}objectbox.{{with $property.Relation}}RelationToOne{{else}}Property{{$property.GoType | TypeIdentifier}}{{end}}{{end}}
     {{end -}}
 	{{range $relation := $entity.Relations -}}
     	{{$relation.Name}} *objectbox.RelationToMany
 	{{end -}}
+	{{range $backlink := $entity.Backlinks -}}
+    	{{$backlink.Name}} *objectbox.{{if $backlink.IsStandalone}}RelationToMany{{else}}RelationToOne{{end}}
+	{{end -}}
 }{
 	{{range $property := $entity.Properties -}}
-    {{$property.Name}}: &objectbox.
+    {{$property.Name}}: {{if $property.ConverterType}}&{{$entity.Name}}{{$property.Name}}Property{
+		Property{{$property.GoType | TypeIdentifier}}: {{end}}&objectbox.
 		{{- with $property.Relation}}RelationToOne{
 			Property:
 		{{- else}}Property{{$property.GoType | TypeIdentifier}}{
@@ -92,7 +117,8 @@ var {{$entity.Name}}_ = struct {
 			Entity: &{{$entity.Name}}Binding.Entity,
 		},{{with $property.Relation}}
 		Target: &{{.Target.Name}}Binding.Entity,{{end}}
-	},
+	},{{if $property.ConverterType}}
+	},{{end}}
     {{end -}}
 	{{range $relation := $entity.Relations -}}
     	{{$relation.Name}}: &objectbox.RelationToMany{
@@ -101,7 +127,133 @@ var {{$entity.Name}}_ = struct {
 			Target: &{{$relation.Target.Name}}Binding.Entity,
 		},
     {{end -}}
+	{{range $backlink := $entity.Backlinks -}}
+    	{{$backlink.Name}}: &objectbox.
+		{{- if $backlink.IsStandalone}}RelationToMany{
+			Id: {{$backlink.Id}},
+			Source: &{{$backlink.Source.Name}}Binding.Entity,
+		{{- else}}RelationToOne{
+			Property: &objectbox.BaseProperty{
+				Id: {{$backlink.Id}},
+				Entity: &{{$backlink.Source.Name}}Binding.Entity,
+			},
+		{{- end}}
+			Target: &{{$entity.Name}}Binding.Entity,
+		},
+    {{end -}}
+}
+{{range $property := $entity.Properties}}{{if $property.ConverterType -}}
+{{$type := print $entity.Name $property.Name "Property" -}}
+{{$base := print "Property" (TypeIdentifier $property.GoType) -}}
+{{$kind := $property.ConverterValueKind -}}
+{{$methods := "Equals NotEquals GreaterThan LessThan" -}}
+{{if eq $kind "float"}}{{$methods = "GreaterThan LessThan"}}
+{{- else if eq $kind "string"}}{{$methods = "Equals NotEquals GreaterThan GreaterOrEqual LessThan LessOrEqual"}}
+{{- else if eq $kind "bytes"}}{{$methods = "Equals GreaterThan GreaterOrEqual LessThan LessOrEqual"}}{{end}}
+// {{$type}} is a query helper for {{$entity.Name}}.{{$property.Path}} accepting {{$property.ConverterType}} values
+// in conditions and query parameters. The values are converted using {{$property.Converter}}ToDatabaseValue().
+type {{$type}} struct {
+	*objectbox.{{$base}}
+}
+
+func (property *{{$type}}) databaseValue(value {{$property.ConverterType}}) ({{$property.GoType}}, error) {
+	dbValue, err := {{$property.Converter}}ToDatabaseValue(value)
+	if err != nil {
+		return dbValue, errors.New("converter {{$property.Converter}}ToDatabaseValue() failed on {{$entity.Name}}.{{$property.Path}}: " + err.Error())
+	}
+	return dbValue, nil
+}
+{{range $method := StringFields $methods}}
+// {{$method}} is like objectbox.{{$base}}.{{$method}}() but accepts a {{$property.ConverterType}} value
+func (property *{{$type}}) {{$method}}(value {{$property.ConverterType}}{{if eq $kind "string"}}, caseSensitive bool{{end}}) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.{{$base}}.{{$method}}(dbValue{{if eq $kind "string"}}, caseSensitive{{end}}), err)
+}
+{{end}}
+{{- if or (eq $kind "int") (eq $kind "float")}}
+// Between is like objectbox.{{$base}}.Between() but accepts {{$property.ConverterType}} values
+func (property *{{$type}}) Between(a, b {{$property.ConverterType}}) objectbox.Condition {
+	dbA, err := property.databaseValue(a)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	dbB, err := property.databaseValue(b)
+	return objectbox.ConditionOrError(property.{{$base}}.Between(dbA, dbB), err)
+}
+{{end}}
+{{- range $inType := StringFields "int int32 int64 uint uint32 uint64 rune"}}{{if eq $inType $property.GoType}}
+// In is like objectbox.{{$base}}.In() but accepts {{$property.ConverterType}} values
+func (property *{{$type}}) In(values ...{{$property.ConverterType}}) objectbox.Condition {
+	dbValues, err := property.databaseValues(values)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	return property.{{$base}}.In(dbValues...)
+}
+
+// NotIn is like objectbox.{{$base}}.NotIn() but accepts {{$property.ConverterType}} values
+func (property *{{$type}}) NotIn(values ...{{$property.ConverterType}}) objectbox.Condition {
+	dbValues, err := property.databaseValues(values)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	return property.{{$base}}.NotIn(dbValues...)
+}
+
+func (property *{{$type}}) databaseValues(values []{{$property.ConverterType}}) ([]{{$property.GoType}}, error) {
+	var dbValues = make([]{{$property.GoType}}, len(values))
+	for k, value := range values {
+		dbValue, err := property.databaseValue(value)
+		if err != nil {
+			return nil, err
+		}
+		dbValues[k] = dbValue
+	}
+	return dbValues, nil
+}
+{{end}}{{end}}
+{{- if eq $property.ConverterType "time.Time"}}
+// After finds entities with the stored time after the given one, see GreaterThan()
+func (property *{{$type}}) After(value time.Time) objectbox.Condition {
+	return property.GreaterThan(value)
+}
+
+// Before finds entities with the stored time before the given one, see LessThan()
+func (property *{{$type}}) Before(value time.Time) objectbox.Condition {
+	return property.LessThan(value)
+}
+{{end}}
+// SetParams changes the parameter values of the condition on this property in the given query,
+// converting them using {{$property.Converter}}ToDatabaseValue()
+func (property *{{$type}}) SetParams(query *objectbox.Query, values ...{{$property.ConverterType}}) error {
+	return property.setParams(query, "", values)
+}
+
+// SetAliasParams changes the parameter values of the condition with the given alias in the given query,
+// converting them using {{$property.Converter}}ToDatabaseValue()
+func (property *{{$type}}) SetAliasParams(query *objectbox.Query, alias string, values ...{{$property.ConverterType}}) error {
+	return property.setParams(query, alias, values)
+}
+{{$setter := "Int64"}}{{$cast := "int64"}}
+{{- if eq $kind "float"}}{{$setter = "Float64"}}{{$cast = "float64"}}
+{{- else if eq $kind "string"}}{{$setter = "String"}}{{$cast = ""}}
+{{- else if eq $kind "bytes"}}{{$setter = "Bytes"}}{{$cast = ""}}{{end}}
+func (property *{{$type}}) setParams(query *objectbox.Query, alias string, values []{{$property.ConverterType}}) error {
+	var dbValues = make([]{{if $cast}}{{$cast}}{{else}}{{$property.GoType}}{{end}}, len(values))
+	for k, value := range values {
+		dbValue, err := property.databaseValue(value)
+		if err != nil {
+			return err
+		}
+		dbValues[k] = {{if $cast}}{{$cast}}(dbValue){{else}}dbValue{{end}}
+	}
+
+	if alias == "" {
+		return query.Set{{$setter}}Params(property, dbValues...)
+	}
+	return query.Set{{$setter}}Params(objectbox.Alias(alias), dbValues...)
 }
+{{end}}{{end}}
 
 // GeneratorVersion is called by ObjectBox to verify the compatibility of the generator used to generate this code	
 func ({{$entityNameCamel}}_EntityInfo) GeneratorVersion() int {
@@ -324,6 +476,7 @@ func ({{$entityNameCamel}}_EntityInfo) Load(ob *objectbox.ObjectBox, bytes []byt
 					{{- if $field.IsLazyLoaded}}nil, // use {{$field.Entity.Name}}Box::Fetch{{$field.Name}}() to fetch this lazy-loaded relation
 					{{- else}}rel{{$field.Name}}
 					{{- end}}
+				{{- else if $field.Backlink}}nil, // use {{$field.Entity.Name}}Box::Fetch{{$field.Name}}() to fetch this lazy-loaded backlink
 				{{- else if $field.IsId}} prop{{$field.Property.Name}}
         		{{- else if $field.Property}}{{template "property-getter-with-converter-val" $field.Property}}
 				{{- else}}{{if $field.IsPointer}}&{{end}}{{$field.Type}}{ {{template "fields-initializer" $field}} }
@@ -358,6 +511,13 @@ func BoxFor{{$entity.Name}}(ob *objectbox.ObjectBox) *{{$entity.Name}}Box {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *{{$entity.Name}}Box) WithTx(tx *objectbox.Tx) *{{$entity.Name}}Box {
+	return &{{$entity.Name}}Box{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the {{$entity.IdProperty.Path}} is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the {{$entity.Name}}.{{$entity.IdProperty.Path}} property on the passed object will be assigned the new ID as well.
@@ -398,6 +558,12 @@ func (box *{{$entity.Name}}Box) PutMany(objects []{{if not $.Options.ByValue}}*{
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *{{$entity.Name}}Box) PutManyContext(ctx context.Context, objects []{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -439,6 +605,21 @@ func (box *{{$entity.Name}}Box) GetAll() ([]{{if not $.Options.ByValue}}*{{end}}
 	return objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *{{$entity.Name}}Box) GetAllContext(ctx context.Context) ([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *{{$entity.Name}}Box) Iterate() *{{$entity.Name}}Iterator {
+	return &{{$entity.Name}}Iterator{box.Box.Iterate()}
+}
+
 {{- block "fetch-related" $entity}}
 {{- range $field := .Fields}}
 	{{/* NOTE, we keep the IF-ELSE branching this way to correctly process embedded structs in the last ELSE */}}
@@ -479,6 +660,64 @@ func (box *{{$entity.Name}}Box) GetAll() ([]{{if not $.Options.ByValue}}*{{end}}
 				return err
 			}
 		{{end}}
+	{{- else if .Backlink}}
+		// Fetch{{.Name}} reads objects pointing to the given ones using the relation {{.Backlink.Source.Name}}::{{.Backlink.Field}}.
+		// It will "GetManyExisting()" all {{.Backlink.Source.Name}} objects linking to each of the given objects
+		// and set object.{{.Name}} to the slice of linking objects, as currently stored in DB.
+		func (box *{{.Entity.Name}}Box) Fetch{{.Name}}(objects ...*{{.Entity.Name}}) error {
+			var slices = make([]{{.Type}}, len(objects))
+			err := box.ObjectBox.RunInReadTx(func() error {
+				var sourceBox = BoxFor{{.Backlink.Source.Name}}(box.ObjectBox)
+
+				// collect slices before setting the objects' fields
+				// this keeps all the objects untouched in case there's an error during any of the requests
+				for k, object := range objects {
+					{{if .Entity.IdProperty.Converter -}}
+					id, err := {{.Entity.IdProperty.Converter}}ToDatabaseValue(object.{{.Entity.IdProperty.Path}})
+					if err != nil {
+						return err
+					}
+					{{end -}}
+					rIds, err := sourceBox.{{if .Backlink.IsStandalone}}RelationBacklinkIds{{else}}BacklinkIds{{end}}({{.Backlink.Source.Name}}_.{{.Backlink.Field}}, {{with .Entity.IdProperty}} {{if .Converter}}id{{else if eq .GoType "uint64"}}object.{{.Path}}{{else}}uint64(object.{{.Path}}){{end}}{{end}})
+					var sources interface{}
+					if err == nil {
+						sources, err = sourceBox.Box.GetManyExisting(rIds...)
+					}
+					if err != nil {
+						return err
+					}
+
+					// the source entity binding may read objects by value or by pointer, independent of this field
+					switch sources := sources.(type) {
+					{{if .Backlink.Source.IsPointer -}}
+					case []*{{.Backlink.Source.Name}}:
+						slices[k] = sources
+					case []{{.Backlink.Source.Name}}:
+						slices[k] = make({{.Type}}, len(sources))
+						for i := range sources {
+							slices[k][i] = &sources[i]
+						}
+					{{- else -}}
+					case []{{.Backlink.Source.Name}}:
+						slices[k] = sources
+					case []*{{.Backlink.Source.Name}}:
+						slices[k] = make({{.Type}}, len(sources))
+						for i, source := range sources {
+							slices[k][i] = *source
+						}
+					{{- end}}
+					}
+				}
+				return nil
+			})
+
+			if err == nil { // update the field on all objects if we got all slices
+				for k := range objects {
+					objects[k].{{.Path}} = slices[k]
+				}
+			}
+			return err
+		}
 	{{- else}}{{/* recursively visit fields in embedded structs */}}{{template "fetch-related" $field}}
 	{{- end}}
 {{- end}}{{end}}
@@ -488,32 +727,28 @@ func (box *{{$entity.Name}}Box) Remove(object *{{$entity.Name}}) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *{{$entity.Name}}Box) RemoveContext(ctx context.Context, object *{{$entity.Name}}) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
 // In case you need to strictly check whether all of the objects exist before removing them,
 // you can execute multiple box.Contains() and box.Remove() inside a single write transaction.
 func (box *{{$entity.Name}}Box) RemoveMany(objects ...*{{$entity.Name}}) (uint64, error) {
-	var ids = make([]uint64, len(objects))
-	{{- if $entity.IdProperty.Converter}}
-	var err error{{end}}
-	for k, object := range objects {
-		{{if $entity.IdProperty.Converter -}}
-			ids[k], err = {{$entity.IdProperty.TplReadValue "object" ""}}
-			if err != nil {
-				return 0, errors.New("converter {{$entity.IdProperty.Converter}}ToDatabaseValue() failed on {{$entity.Name}}.{{$entity.IdProperty.Path}}: " + err.Error())
-			}
-		{{else -}}
-			ids[k] = {{with $entity.IdProperty -}}
-				{{- if not (eq .GoType "uint64")}} uint64( {{end -}}
-				object.{{.Path}}
-				{{- if not (eq .GoType "uint64")}} ) {{end -}}
-			{{- end}}
-		{{end -}}
-	}
+	{{- template "object-ids" $entity}}
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *{{$entity.Name}}Box) RemoveManyContext(ctx context.Context, objects ...*{{$entity.Name}}) (uint64, error) {
+	{{- template "object-ids" $entity}}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the {{$entity.Name}}_ struct to create conditions.
 // Keep the *{{$entity.Name}}Query if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -596,6 +831,30 @@ func (asyncBox *{{$entity.Name}}AsyncBox) Remove(object *{{$entity.Name}}) error
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// {{$entity.Name}}Iterator streams stored objects one by one, see {{$entity.Name}}Box.Iterate()
+type {{$entity.Name}}Iterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *{{$entity.Name}}Iterator) Seek(id uint64) *{{$entity.Name}}Iterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *{{$entity.Name}}Iterator) Reverse() *{{$entity.Name}}Iterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *{{$entity.Name}}Iterator) ForEach(fn func(*{{$entity.Name}}) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*{{$entity.Name}}))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all {{$entity.Name}} which {{$entity.IdProperty.Name}} is either 42 or 47:
@@ -613,6 +872,58 @@ func (query *{{$entity.Name}}Query) Find() ([]{{if not $.Options.ByValue}}*{{end
 	return objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *{{$entity.Name}}Query) FindContext(ctx context.Context) ([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *{{$entity.Name}}Query) FindFirst() (*{{$entity.Name}}, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*{{$entity.Name}}), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *{{$entity.Name}}Query) FindUnique() (*{{$entity.Name}}, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*{{$entity.Name}}), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *{{$entity.Name}}Query) Page(size uint64, afterToken string) ([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *{{$entity.Name}}Query) Filter(fn func(*{{$entity.Name}}) bool) *{{$entity.Name}}Query {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*{{$entity.Name}}))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *{{$entity.Name}}Query) Offset(offset uint64) *{{$entity.Name}}Query {
 	query.Query.Offset(offset)
@@ -624,4 +935,24 @@ func (query *{{$entity.Name}}Query) Limit(limit uint64) *{{$entity.Name}}Query {
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *{{$entity.Name}}Query) ForEach(fn func(*{{$entity.Name}}) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*{{$entity.Name}}))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *{{$entity.Name}}Query) Subscribe(fn func([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]{{if not $.Options.ByValue}}*{{end}}{{$entity.Name}}), nil)
+		}
+	})
+}
 {{end -}}`))
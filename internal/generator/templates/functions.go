@@ -22,7 +22,8 @@ import (
 )
 
 var funcMap = template.FuncMap{
-	"StringTitle": strings.Title,
+	"StringTitle":  strings.Title,
+	"StringFields": strings.Fields,
 	"StringCamel": func(s string) string {
 		result := strings.Title(s)
 		return strings.ToLower(result[0:1]) + result[1:]
@@ -22,6 +22,7 @@ package objectbox
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"unsafe"
 )
@@ -165,6 +166,12 @@ func (async *AsyncBox) AwaitCompletion() error {
 	})
 }
 
+// AwaitCompletionContext is like AwaitCompletion but stops waiting when the context is done, returning ctx.Err().
+// Note: the submitted operations are not cancelled, they continue to be processed in the background.
+func (async *AsyncBox) AwaitCompletionContext(ctx context.Context) error {
+	return awaitContext(ctx, async.AwaitCompletion)
+}
+
 // AwaitSubmitted for previously submitted async operations to be completed (the async queue does not have to become idle).
 // Currently this is not limited to the single entity this AsyncBox is working on but all entities in the store.
 // Returns an error if shutting down or an error occurred
@@ -173,3 +180,28 @@ func (async *AsyncBox) AwaitSubmitted() error {
 		return bool(C.obx_store_await_async_submitted(async.box.ObjectBox.store))
 	})
 }
+
+// AwaitSubmittedContext is like AwaitSubmitted but stops waiting when the context is done, returning ctx.Err().
+// Note: the submitted operations are not cancelled, they continue to be processed in the background.
+func (async *AsyncBox) AwaitSubmittedContext(ctx context.Context) error {
+	return awaitContext(ctx, async.AwaitSubmitted)
+}
+
+// awaitContext runs the blocking native wait on a separate goroutine so that the caller can stop waiting for it
+func awaitContext(ctx context.Context, await func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var result = make(chan error, 1) // buffered so that the goroutine can finish even if nobody waits for the result
+	go func() {
+		result <- await()
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
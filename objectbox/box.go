@@ -23,6 +23,7 @@ package objectbox
 import "C"
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -38,6 +39,7 @@ type Box struct {
 	entity    *entity
 	cBox      *C.OBX_box
 	async     *AsyncBox
+	tx        *Tx // set for boxes bound to an explicit transaction, see WithTx()
 }
 
 const defaultSliceCapacity = 16
@@ -75,6 +77,23 @@ func (box *Box) Async() *AsyncBox {
 	return box.async
 }
 
+// WithTx returns a copy of this box bound to the given transaction, see ObjectBox.Begin().
+// Operations on the returned box are executed as part of the transaction and fail once the transaction has finished.
+// Note: asynchronous operations (see Async()) are never part of the transaction.
+func (box *Box) WithTx(tx *Tx) *Box {
+	var txBox = *box
+	txBox.tx = tx
+	return &txBox
+}
+
+// checkTx verifies the transaction this box is bound to (if any) is still active
+func (box *Box) checkTx() error {
+	if box.tx == nil {
+		return nil
+	}
+	return box.tx.check(box.ObjectBox)
+}
+
 // Query creates a query with the given conditions. Use generated properties to create conditions.
 // Keep the Query object if you intend to execute it multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -104,6 +123,9 @@ func (box *Box) QueryOrError(conditions ...Condition) (query *Query, err error)
 	}
 
 	query, err = builder.Build(box)
+	if query != nil {
+		query.conditions = conditions
+	}
 
 	return // NOTE result might be overwritten by the deferred "closer" function
 }
@@ -142,6 +164,10 @@ func (box *Box) idsForPut(count int) (firstId uint64, err error) {
 }
 
 func (box *Box) put(object interface{}, alreadyInTx bool, putMode C.OBXPutMode) (id uint64, err error) {
+	if err := box.checkTx(); err != nil {
+		return 0, err
+	}
+
 	idFromObject, err := box.entity.binding.GetId(object)
 	if err != nil {
 		return 0, err
@@ -252,6 +278,16 @@ func (box *Box) Update(object interface{}) error {
 //
 // Note: The slice may be empty or even nil; in both cases, an empty IDs slice and no error is returned.
 func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
+	return box.PutManyContext(context.Background(), objects)
+}
+
+// PutManyContext is like PutMany but stops processing the objects when the context is done, between chunks of objects.
+// In that case, the transaction is rolled back and ctx.Err() is returned.
+func (box *Box) PutManyContext(ctx context.Context, objects interface{}) (ids []uint64, err error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
 	var slice = reflect.ValueOf(objects)
 	var count = slice.Len()
 
@@ -265,7 +301,7 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 
 	// Execute everything in a single single transaction - for performance and consistency.
 	// This is necessary even if count < chunkSize because of relations (PutRelated)
-	err = box.ObjectBox.RunInWriteTx(func() error {
+	err = box.ObjectBox.RunInWriteTxContext(ctx, func() error {
 		if supportsBytesArray {
 			// Process the data in chunks so that we don't consume too much memory.
 			const chunkSize = 10000 // 10k is the limit currently enforced by obx_box_ids_for_put, maybe make configurable
@@ -276,6 +312,10 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 			}
 
 			for c := 0; c < chunks; c++ {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
 				var start = c * chunkSize
 				var end = start + chunkSize
 				if end > count {
@@ -288,6 +328,10 @@ func (box *Box) PutMany(objects interface{}) (ids []uint64, err error) {
 			}
 		} else {
 			for i := 0; i < count; i++ {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
 				id, err := box.put(slice.Index(i).Interface(), true, cPutModePut)
 				if err != nil {
 					return err
@@ -403,17 +447,33 @@ func (box *Box) Remove(object interface{}) error {
 
 // RemoveId deletes a single object
 func (box *Box) RemoveId(id uint64) error {
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_remove(box.cBox, C.obx_id(id))
 	})
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *Box) RemoveContext(ctx context.Context, object interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return box.Remove(object)
+}
+
 // RemoveIds deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
 // In case you need to strictly check whether all of the objects exist before removing them,
 // you can execute multiple box.Contains() and box.Remove() inside a single write transaction.
 func (box *Box) RemoveIds(ids ...uint64) (uint64, error) {
+	if err := box.checkTx(); err != nil {
+		return 0, err
+	}
+
 	cIds, err := goIdsArrayToC(ids)
 	if err != nil {
 		return 0, err
@@ -426,9 +486,49 @@ func (box *Box) RemoveIds(ids ...uint64) (uint64, error) {
 	return uint64(cResult), err
 }
 
+// RemoveIdsContext is like RemoveIds but stops removing the objects when the context is done, between chunks of IDs.
+// In that case, the transaction is rolled back and ctx.Err() is returned.
+func (box *Box) RemoveIdsContext(ctx context.Context, ids ...uint64) (count uint64, err error) {
+	if err := box.checkTx(); err != nil {
+		return 0, err
+	}
+
+	// same as the PutMany() chunk size, keeping the native IDs array reasonably small
+	const chunkSize = 10000
+
+	err = box.ObjectBox.RunInWriteTxContext(ctx, func() error {
+		for start := 0; start < len(ids); start += chunkSize {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			var end = start + chunkSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			removed, err := box.RemoveIds(ids[start:end]...)
+			if err != nil {
+				return err
+			}
+			count += removed
+		}
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 // RemoveAll removes all stored objects.
 // This is much faster than removing objects one by one in a loop.
 func (box *Box) RemoveAll() error {
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_remove_all(box.cBox, nil)
 	})
@@ -442,6 +542,10 @@ func (box *Box) Count() (uint64, error) {
 // CountMax returns a number of objects stored (up to a given maximum)
 // passing limit=0 is the same as calling Count() - counts all objects without a limit
 func (box *Box) CountMax(limit uint64) (uint64, error) {
+	if err := box.checkTx(); err != nil {
+		return 0, err
+	}
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_box_count(box.cBox, C.uint64_t(limit), &cResult) }); err != nil {
 		return 0, err
@@ -451,6 +555,10 @@ func (box *Box) CountMax(limit uint64) (uint64, error) {
 
 // IsEmpty checks whether the box contains any objects
 func (box *Box) IsEmpty() (bool, error) {
+	if err := box.checkTx(); err != nil {
+		return false, err
+	}
+
 	var cResult C.bool
 	if err := cCall(func() C.obx_err { return C.obx_box_is_empty(box.cBox, &cResult) }); err != nil {
 		return false, err
@@ -458,12 +566,22 @@ func (box *Box) IsEmpty() (bool, error) {
 	return bool(cResult), nil
 }
 
+// Subscribe creates an observer notified after each committed transaction changing objects in this box.
+// See ObjectBox.Subscribe() for more details; don't forget to Close() the observer when it's no longer needed.
+func (box *Box) Subscribe() (*Observer, error) {
+	return box.ObjectBox.Subscribe(box.entity.id)
+}
+
 // Get reads a single object.
 //
 // Returns an interface that should be cast to the appropriate type.
 // Returns nil in case the object with the given ID doesn't exist.
 // The cast is done automatically when using the generated BoxFor* code.
 func (box *Box) Get(id uint64) (object interface{}, err error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
 	// we need a read-transaction to keep the data in dataPtr untouched (by concurrent write) until we can read it
 	// as well as making sure the relations read in binding.Load represent a consistent state
 	err = box.ObjectBox.RunInReadTx(func() error {
@@ -545,7 +663,19 @@ func (box *Box) GetAll() (slice interface{}, err error) {
 	return box.readUsingVisitor(existingOnly, cFn)
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *Box) GetAllContext(ctx context.Context) (slice interface{}, err error) {
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_box_visit_all(box.cBox, dataVisitor, visitorArg)
+	}
+	return box.readUsingVisitorContext(ctx, cFn)
+}
+
 func (box *Box) readManyObjects(existingOnly bool, cFn func() *C.OBX_bytes_array) (slice interface{}, err error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
 	// we need a read-transaction to keep the data in dataPtr untouched (by concurrent write) until we can read it
 	// as well as making sure the relations read in binding.Load represent a consistent state
 	err = box.ObjectBox.RunInReadTx(func() error {
@@ -583,6 +713,10 @@ func (box *Box) readManyObjects(existingOnly bool, cFn func() *C.OBX_bytes_array
 
 // this is a utility function to fetch objects using an obx_data_visitor
 func (box *Box) readUsingVisitor(existingOnly bool, cFn func(visitorArg unsafe.Pointer) C.obx_err) (slice interface{}, err error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
 	var binding = box.entity.binding
 	var visitor uint32
 	visitor, err = dataVisitorRegister(func(bytes []byte) bool {
@@ -625,8 +759,78 @@ func (box *Box) readUsingVisitor(existingOnly bool, cFn func(visitorArg unsafe.P
 	}
 }
 
+// readUsingVisitorContext reads objects using an obx_data_visitor, checking the context before each object.
+// Objects not found are skipped, i.e. it's equivalent to readUsingVisitor() with existingOnly=true.
+func (box *Box) readUsingVisitorContext(ctx context.Context, cFn func(visitorArg unsafe.Pointer) C.obx_err) (slice interface{}, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var binding = box.entity.binding
+	slice = binding.MakeSlice(defaultSliceCapacity)
+	err = box.visitObjects(cFn, nil, func(object interface{}) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		slice = binding.AppendToSlice(slice, object)
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return slice, nil
+}
+
+// visitObjects streams objects read using an obx_data_visitor to fn, one by one, without collecting them in a slice.
+// Objects whose data isn't accepted by match (if not nil) are skipped without loading them.
+// The visitation stops when fn returns false or an error.
+func (box *Box) visitObjects(cFn func(visitorArg unsafe.Pointer) C.obx_err, match func(bytes []byte) bool, fn func(object interface{}) (bool, error)) (err error) {
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
+	var binding = box.entity.binding
+	visitor, err := dataVisitorRegister(func(bytes []byte) bool {
+		if bytes == nil || (match != nil && !match(bytes)) {
+			return true
+		}
+
+		object, err2 := binding.Load(box.ObjectBox, bytes)
+		if err2 != nil {
+			err = err2
+			return false
+		}
+
+		next, err2 := fn(object)
+		if err2 != nil {
+			err = err2
+			return false
+		}
+		return next
+	})
+	if err != nil {
+		return err
+	}
+	defer dataVisitorUnregister(visitor)
+
+	// see readUsingVisitor() for why a read transaction is necessary and why we need a separate error variable
+	var err2 = box.ObjectBox.RunInReadTx(func() error {
+		return cCall(func() C.obx_err { return cFn(unsafe.Pointer(&visitor)) })
+	})
+
+	if err2 != nil {
+		return err2
+	}
+	return err
+}
+
 // Contains checks whether an object with the given ID is stored.
 func (box *Box) Contains(id uint64) (bool, error) {
+	if err := box.checkTx(); err != nil {
+		return false, err
+	}
+
 	var cResult C.bool
 	if err := cCall(func() C.obx_err { return C.obx_box_contains(box.cBox, C.obx_id(id), &cResult) }); err != nil {
 		return false, err
@@ -636,6 +840,10 @@ func (box *Box) Contains(id uint64) (bool, error) {
 
 // ContainsIds checks whether all of the given objects are stored in DB.
 func (box *Box) ContainsIds(ids ...uint64) (bool, error) {
+	if err := box.checkTx(); err != nil {
+		return false, err
+	}
+
 	cIds, err := goIdsArrayToC(ids)
 	if err != nil {
 		return false, err
@@ -650,6 +858,10 @@ func (box *Box) ContainsIds(ids ...uint64) (bool, error) {
 
 // RelationIds returns IDs of all target objects related to the given source object ID
 func (box *Box) RelationIds(relation *RelationToMany, sourceId uint64) ([]uint64, error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
 	targetBox, err := box.ObjectBox.box(relation.Target.Id)
 	if err != nil {
 		return nil, err
@@ -659,11 +871,47 @@ func (box *Box) RelationIds(relation *RelationToMany, sourceId uint64) ([]uint64
 	})
 }
 
+// RelationBacklinkIds returns IDs of all source objects related to the given target object ID.
+// It is the reverse direction of RelationIds(), e.g. finding all groups a given member belongs to.
+func (box *Box) RelationBacklinkIds(relation *RelationToMany, targetId uint64) ([]uint64, error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
+	sourceBox, err := box.ObjectBox.box(relation.Source.Id)
+	if err != nil {
+		return nil, err
+	}
+	return cGetIds(func() *C.OBX_id_array {
+		return C.obx_box_rel_get_backlink_ids(sourceBox.cBox, C.obx_schema_id(relation.Id), C.obx_id(targetId))
+	})
+}
+
+// BacklinkIds returns IDs of all objects pointing to the given target object ID using a to-one relation property.
+// E.g. finding all orders of a customer, given the Order_.Customer relation and a customer ID.
+func (box *Box) BacklinkIds(relation *RelationToOne, targetId uint64) ([]uint64, error) {
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
+	sourceBox, err := box.ObjectBox.box(relation.Property.Entity.Id)
+	if err != nil {
+		return nil, err
+	}
+	return cGetIds(func() *C.OBX_id_array {
+		return C.obx_box_get_backlink_ids(sourceBox.cBox, C.obx_schema_id(relation.Property.Id), C.obx_id(targetId))
+	})
+}
+
 // RelationReplace replaces all targets for a given source in a standalone many-to-many relation
 // It also inserts new related objects (with a 0 ID).
 func (box *Box) RelationReplace(relation *RelationToMany, sourceId uint64, sourceObject interface{},
 	targetObjects interface{}) error {
 
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
 	// get id from the object, if inserting, it would be 0 even if the argument id is already non-zero
 	// this saves us an unnecessary request to RelationIds for new objects (there can't be any relations yet)
 	id, err := box.entity.binding.GetId(sourceObject)
@@ -745,6 +993,10 @@ func (box *Box) RelationReplace(relation *RelationToMany, sourceId uint64, sourc
 
 // RelationPut creates a relation between the given source & target objects
 func (box *Box) RelationPut(relation *RelationToMany, sourceId, targetId uint64) error {
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_rel_put(box.cBox, C.obx_schema_id(relation.Id), C.obx_id(sourceId), C.obx_id(targetId))
 	})
@@ -752,6 +1004,10 @@ func (box *Box) RelationPut(relation *RelationToMany, sourceId, targetId uint64)
 
 // RelationRemove removes a relation between the given source & target objects
 func (box *Box) RelationRemove(relation *RelationToMany, sourceId, targetId uint64) error {
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
 	return cCall(func() C.obx_err {
 		return C.obx_box_rel_remove(box.cBox, C.obx_schema_id(relation.Id), C.obx_id(sourceId), C.obx_id(targetId))
 	})
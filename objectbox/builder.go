@@ -125,6 +125,23 @@ func (builder *Builder) Model(model *Model) *Builder {
 	return builder
 }
 
+// ModelFromJSON specifies schema for the database by reading the model JSON file (objectbox-model.json),
+// as maintained by the generator. Use it to open the database in programs that don't include the generated code,
+// e.g. generic tooling or data migrations. Objects are then accessed as maps using ObjectBox.DynamicBox().
+func (builder *Builder) ModelFromJSON(path string) *Builder {
+	if builder.Error != nil {
+		return builder
+	}
+
+	model, err := modelFromJSONFile(path)
+	if err != nil {
+		builder.Error = err
+		return builder
+	}
+
+	return builder.Model(model)
+}
+
 // Build validates the configuration and tries to init the ObjectBox.
 // This call panics on failures; if ObjectBox is optional for your app, consider BuildOrError().
 func (builder *Builder) Build() (*ObjectBox, error) {
@@ -184,6 +201,7 @@ func (builder *Builder) BuildOrError() (*ObjectBox, error) {
 		entitiesById:   builder.model.entitiesById,
 		entitiesByName: builder.model.entitiesByName,
 		boxes:          make(map[TypeId]*Box, len(builder.model.entitiesById)),
+		observers:      make(map[uint32]*Observer),
 		options:        builder.options,
 	}
 
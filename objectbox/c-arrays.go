@@ -205,3 +205,94 @@ func cVoidPtrToByteSlice(data unsafe.Pointer, size int, bytes *[]byte) {
 	header.Len = size
 	header.Cap = size
 }
+
+func cStringArrayToGo(cArray *C.OBX_string_array) []string {
+	var size = uint(cArray.count)
+	var result = make([]string, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = C.GoString(*(**C.char)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize)))
+		}
+	}
+	return result
+}
+
+func cInt64ArrayToGo(cArray *C.OBX_int64_array) []int64 {
+	var size = uint(cArray.count)
+	var result = make([]int64, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*int64)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
+
+func cInt32ArrayToGo(cArray *C.OBX_int32_array) []int32 {
+	var size = uint(cArray.count)
+	var result = make([]int32, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*int32)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
+
+func cInt16ArrayToGo(cArray *C.OBX_int16_array) []int16 {
+	var size = uint(cArray.count)
+	var result = make([]int16, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*int16)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
+
+func cInt8ArrayToGo(cArray *C.OBX_int8_array) []int8 {
+	var size = uint(cArray.count)
+	var result = make([]int8, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*int8)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
+
+func cDoubleArrayToGo(cArray *C.OBX_double_array) []float64 {
+	var size = uint(cArray.count)
+	var result = make([]float64, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*float64)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
+
+func cFloatArrayToGo(cArray *C.OBX_float_array) []float32 {
+	var size = uint(cArray.count)
+	var result = make([]float32, size)
+	if size > 0 {
+		var cArrayStart = unsafe.Pointer(cArray.items)
+		var cSize = unsafe.Sizeof(*cArray.items)
+		for i := uint(0); i < size; i++ {
+			result[i] = *(*float32)(unsafe.Pointer(uintptr(cArrayStart) + uintptr(i)*cSize))
+		}
+	}
+	return result
+}
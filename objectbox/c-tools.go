@@ -88,5 +88,9 @@ func createError() error {
 	if msg == nil {
 		return errors.New("no error info available; please report")
 	}
-	return errors.New(C.GoString(msg))
+	return &Error{
+		Code:          int(C.obx_last_error_code()),
+		SecondaryCode: int(C.obx_last_error_secondary()),
+		Message:       C.GoString(msg),
+	}
 }
@@ -37,6 +37,7 @@ type ConditionId = int32
 
 const conditionIdFakeOrder = -1
 const conditionIdFakeLink = -2
+const conditionIdFakeMatch = -3
 
 type conditionClosure struct {
 	apply func(qb *QueryBuilder) (ConditionId, error)
@@ -44,11 +45,25 @@ type conditionClosure struct {
 }
 
 func (condition *conditionClosure) applyTo(qb *QueryBuilder, isRoot bool) (ConditionId, error) {
+	var planConditions = len(qb.planConditions)
+
 	cid, err := condition.apply(qb)
 	if err != nil {
 		return 0, err
 	}
 
+	// a negated condition may be replaced by multiple native ones, e.g. NOT(a > 5) by a < 5 OR a == 5;
+	// an alias would only be set on their combination and parameters can't be set by property unambiguously
+	if qb.negated && len(qb.planConditions)-planConditions > 1 {
+		if condition.alias != nil {
+			return 0, fmt.Errorf("using Alias/As(\"%s\") on a negated condition which is replaced by multiple "+
+				"conditions is not supported", *condition.alias)
+		}
+		qb.expandedConditions = append(qb.expandedConditions, qb.planConditions[planConditions:]...)
+	}
+
+	qb.planIndex = planIndexOf(qb.planConditions[planConditions:])
+
 	if condition.alias != nil {
 		err = qb.Alias(*condition.alias)
 		if err != nil {
@@ -94,35 +109,54 @@ func (condition *conditionCombination) applyTo(qb *QueryBuilder, isRoot bool) (C
 	}
 
 	if len(condition.conditions) == 0 {
+		qb.planIndex = planIndexNone
 		return 0, nil
 	} else if len(condition.conditions) == 1 {
 		return condition.conditions[0].applyTo(qb, isRoot)
 	}
 
+	// De Morgan's laws: NOT(a AND b) = NOT(a) OR NOT(b) and vice versa; the sub-conditions are negated by the builder
+	var or = condition.or != qb.negated
+
+	// conditions evaluated in Go (see QueryBuilder.match()) can't be part of an OR combination
+	if or {
+		qb.anyDepth++
+		defer func() { qb.anyDepth-- }()
+	}
+
+	var index = planIndexNone
+	defer func() { qb.planIndex = index }()
+
 	ids := make([]ConditionId, 0, len(condition.conditions))
 	for _, sub := range condition.conditions {
 		cid, err := sub.applyTo(qb, false)
 		if err != nil {
 			return 0, err
 		}
+		index = index.combine(qb.planIndex, or)
 
-		// Skip order pseudo conditions.
+		// Skip order and Go-side match pseudo conditions.
 		// Note: conditionIdFakeLink is allowed here and is caught below if used in non-root or in an "ALL" combination.
-		if cid != conditionIdFakeOrder {
+		if cid != conditionIdFakeOrder && cid != conditionIdFakeMatch {
 			ids = append(ids, cid)
 		}
 	}
 
 	// root All (AND) is implicit so no need to actually combine the conditions
-	if isRoot && !condition.or {
+	if isRoot && !or {
 		return 0, nil
 	}
 
+	// there's nothing to combine natively, e.g. only Go-side match pseudo conditions in a nested All
+	if len(ids) == 0 {
+		return conditionIdFakeMatch, nil
+	}
+
 	if err := condition.assertNoLinks(ids); err != nil {
 		return 0, err
 	}
 
-	if condition.or {
+	if or {
 		return qb.Any(ids)
 	}
 
@@ -158,6 +192,72 @@ func All(conditions ...Condition) Condition {
 	}
 }
 
+// Negates a condition
+type conditionNegation struct {
+	condition Condition
+	alias     *string // this is only used to report an error
+}
+
+func (condition *conditionNegation) applyTo(qb *QueryBuilder, isRoot bool) (ConditionId, error) {
+	if condition.alias != nil {
+		return 0, fmt.Errorf("using Alias/As(\"%s\") on a negated condition is not supported", *condition.alias)
+	}
+
+	qb.negated = !qb.negated
+	defer func() { qb.negated = !qb.negated }()
+
+	return condition.condition.applyTo(qb, isRoot)
+}
+
+// Alias sets a string alias for the given condition. It can later be used in Query.Set*Params() methods.
+// This is an invalid call on a negated condition and will result in an error.
+func (condition *conditionNegation) Alias(alias string) Condition {
+	condition.alias = &alias
+	return condition
+}
+
+// As sets an alias for the given condition. It can later be used in Query.Set*Params() methods.
+// This is an invalid call on a negated condition and will result in an error.
+func (condition *conditionNegation) As(alias *alias) Condition {
+	condition.alias = alias.alias()
+	return condition
+}
+
+// Not negates the given condition (equivalent to NOT logical operator).
+// As there's no native negation, the condition is replaced by its complement, e.g. Equals() by NotEquals(),
+// GreaterThan() by LessThan() OR Equals(), and combinations are negated using De Morgan's laws.
+// Negating some conditions, e.g. string Contains(), isn't supported and results in an error. The same applies to
+// conditions on linked entities (Link/backlinks): the core can't negate a link, so negated links are always rejected.
+// A condition replaced by multiple ones, e.g. Not(GreaterThan()), can't be aliased and its parameters can't be changed
+// using Set*Params(); Equals() and similar conditions with a single complement don't have this limitation.
+// Note: objects with a nil property value may match neither a condition nor its negation; add IsNil() if necessary.
+func Not(condition Condition) Condition {
+	return &conditionNegation{condition: condition}
+}
+
+// None provides a way to match objects not matching any of the given conditions (equivalent to NOT(a OR b ...))
+func None(conditions ...Condition) Condition {
+	return Not(Any(conditions...))
+}
+
+// Xor matches objects matching exactly one of the two given conditions (equivalent to XOR logical operator)
+func Xor(a, b Condition) Condition {
+	return Any(All(a, Not(b)), All(Not(a), b))
+}
+
+// ConditionOrError returns the condition, or a condition failing the query creation with the given error, if not nil.
+// It's used by the generated code to report errors of property converters called when creating conditions.
+func ConditionOrError(condition Condition, err error) Condition {
+	if err != nil {
+		return &conditionClosure{
+			apply: func(qb *QueryBuilder) (ConditionId, error) {
+				return 0, err
+			},
+		}
+	}
+	return condition
+}
+
 // implements propertyOrAlias
 type alias struct {
 	string
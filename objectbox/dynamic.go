@@ -0,0 +1,563 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/google/flatbuffers/go"
+	"github.com/objectbox/objectbox-go/internal/generator"
+	"github.com/objectbox/objectbox-go/objectbox/fbutils"
+)
+
+// DynamicBox provides CRUD access to objects without the generated binding code, e.g. in generic tooling.
+// Objects are represented as maps of property names to values, with value types based on the property type:
+// bool, (u)int8, (u)int16, (u)int32, (u)int64, float32, float64, string, []byte and []string.
+// Dates are represented as int64 (milliseconds since the Unix epoch) and relations as uint64 target IDs.
+//
+// When storing objects, numeric values of any Go type are accepted as long as they fit the property type.
+// Properties missing in the map (or nil) are not stored and are read back as nil.
+type DynamicBox struct {
+	*Box
+	binding *dynamicBinding
+}
+
+// DynamicBox returns a box accessing objects of the given entity as maps, see DynamicBox for details.
+// It can be used with any model, including a model read by Builder.ModelFromJSON().
+func (ob *ObjectBox) DynamicBox(entityName string) (*DynamicBox, error) {
+	var entity = ob.entitiesByName[entityName]
+	if entity == nil {
+		return nil, fmt.Errorf("entity %s not found in the model", entityName)
+	}
+
+	box, err := ob.box(entity.id)
+	if err != nil {
+		return nil, err
+	}
+
+	var binding = &dynamicBinding{entity: entity}
+
+	// a shallow copy of the box and the entity using a dynamic binding, otherwise sharing the native resources
+	var dynamicEntity = *entity
+	dynamicEntity.binding = binding
+	dynamicEntity.hasRelations = false // related objects are not handled by the dynamic binding
+
+	var dynamicBox = *box
+	dynamicBox.entity = &dynamicEntity
+	dynamicBox.async = &AsyncBox{
+		box:    &dynamicBox,
+		cAsync: box.async.cAsync,
+		cOwned: false,
+	}
+
+	return &DynamicBox{Box: &dynamicBox, binding: binding}, nil
+}
+
+// EntityNames returns names of all entities in the model, sorted alphabetically
+func (ob *ObjectBox) EntityNames() []string {
+	var names = make([]string, 0, len(ob.entitiesByName))
+	for name := range ob.entitiesByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// WithTx returns a copy of this box bound to the given transaction, see Box.WithTx().
+func (box *DynamicBox) WithTx(tx *Tx) *DynamicBox {
+	return &DynamicBox{Box: box.Box.WithTx(tx), binding: box.binding}
+}
+
+// PropertyNames returns names of all properties of the entity, in the order of declaration.
+func (box *DynamicBox) PropertyNames() []string {
+	var names = make([]string, len(box.binding.entity.properties))
+	for k, property := range box.binding.entity.properties {
+		names[k] = property.name
+	}
+	return names
+}
+
+// Property returns a query-building property for the given property name, as the generated code would declare it,
+// e.g. *PropertyString for a string property or *PropertyInt64 for a date property.
+// Use a type switch on the result to build conditions for Box.Query().
+func (box *DynamicBox) Property(name string) (interface{}, error) {
+	for _, property := range box.binding.entity.properties {
+		if property.name == name {
+			return property.queryProperty(box.binding.entity)
+		}
+	}
+	return nil, fmt.Errorf("property %s not found in entity %s", name, box.binding.entity.name)
+}
+
+// Put synchronously inserts/updates a single object and sets the ID property in the map.
+// In case the ID is not specified, it would be assigned automatically (auto-increment).
+func (box *DynamicBox) Put(object map[string]interface{}) (uint64, error) {
+	return box.Box.Put(object)
+}
+
+// PutMany inserts multiple objects in single transaction.
+// The IDs of the newly created objects are set in the given maps.
+func (box *DynamicBox) PutMany(objects []map[string]interface{}) ([]uint64, error) {
+	return box.Box.PutMany(objects)
+}
+
+// Get reads a single object.
+//
+// Returns nil (and no error) in case the object with the given ID doesn't exist.
+func (box *DynamicBox) Get(id uint64) (map[string]interface{}, error) {
+	object, err := box.Box.Get(id)
+	if err != nil {
+		return nil, err
+	} else if object == nil {
+		return nil, nil
+	}
+	return object.(map[string]interface{}), nil
+}
+
+// GetMany reads multiple objects at once.
+// If any of the objects doesn't exist, its position in the return slice is nil
+func (box *DynamicBox) GetMany(ids ...uint64) ([]map[string]interface{}, error) {
+	objects, err := box.Box.GetMany(ids...)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]map[string]interface{}), nil
+}
+
+// GetAll reads all stored objects
+func (box *DynamicBox) GetAll() ([]map[string]interface{}, error) {
+	objects, err := box.Box.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]map[string]interface{}), nil
+}
+
+// Remove deletes a single object
+func (box *DynamicBox) Remove(object map[string]interface{}) error {
+	return box.Box.Remove(object)
+}
+
+// dynamicBinding implements ObjectBinding for map[string]interface{} objects, based on the properties in the model
+type dynamicBinding struct {
+	entity *entity
+}
+
+// AddToModel is not supported - the binding is created for an entity already in the model
+func (binding *dynamicBinding) AddToModel(model *Model) {
+	model.Error = fmt.Errorf("dynamic binding for entity %s can't be added to a model", binding.entity.name)
+}
+
+func (binding *dynamicBinding) idPropertyName() (string, error) {
+	for _, property := range binding.entity.properties {
+		if property.id == binding.entity.idProperty {
+			return property.name, nil
+		}
+	}
+	return "", fmt.Errorf("ID property of entity %s is unknown", binding.entity.name)
+}
+
+// GetId reads the ID from the object map
+func (binding *dynamicBinding) GetId(object interface{}) (uint64, error) {
+	name, err := binding.idPropertyName()
+	if err != nil {
+		return 0, err
+	}
+
+	value, found := object.(map[string]interface{})[name]
+	if !found || value == nil {
+		return 0, nil
+	}
+	return dynamicUint(value, 64)
+}
+
+// SetId sets the ID in the object map
+func (binding *dynamicBinding) SetId(object interface{}, id uint64) error {
+	name, err := binding.idPropertyName()
+	if err != nil {
+		return err
+	}
+
+	object.(map[string]interface{})[name] = id
+	return nil
+}
+
+// PutRelated does nothing - relations are accessed by their IDs, as stored in the object map
+func (binding *dynamicBinding) PutRelated(ob *ObjectBox, object interface{}, id uint64) error {
+	return nil
+}
+
+// Flatten serializes the object map to FlatBuffers
+func (binding *dynamicBinding) Flatten(object interface{}, fbb *flatbuffers.Builder, id uint64) error {
+	var obj = object.(map[string]interface{})
+	var properties = binding.entity.properties
+
+	// offsets (strings & vectors) must be created before the object is started
+	var offsets = make([]flatbuffers.UOffsetT, len(properties))
+	for k, property := range properties {
+		var value = obj[property.name]
+		if value == nil {
+			continue
+		}
+
+		switch property.typ {
+		case C.OBXPropertyType_String:
+			if str, ok := value.(string); ok {
+				offsets[k] = fbutils.CreateStringOffset(fbb, str)
+			} else {
+				return property.typeError(value)
+			}
+		case C.OBXPropertyType_ByteVector:
+			if bytes, ok := value.([]byte); ok {
+				offsets[k] = fbutils.CreateByteVectorOffset(fbb, bytes)
+			} else {
+				return property.typeError(value)
+			}
+		case C.OBXPropertyType_StringVector:
+			if strings, ok := value.([]string); ok {
+				offsets[k] = fbutils.CreateStringVectorOffset(fbb, strings)
+			} else if values, ok := value.([]interface{}); ok { // e.g. a slice decoded from JSON
+				var strings = make([]string, len(values))
+				for i, v := range values {
+					if strings[i], ok = v.(string); !ok {
+						return property.typeError(value)
+					}
+				}
+				offsets[k] = fbutils.CreateStringVectorOffset(fbb, strings)
+			} else {
+				return property.typeError(value)
+			}
+		}
+	}
+
+	var lastPropertyId TypeId
+	for _, property := range properties {
+		if lastPropertyId < property.id {
+			lastPropertyId = property.id
+		}
+	}
+
+	fbb.StartObject(int(lastPropertyId))
+	for k, property := range properties {
+		var slot = int(property.id - 1)
+
+		if property.id == binding.entity.idProperty {
+			fbutils.SetUint64Slot(fbb, slot, id)
+			continue
+		} else if offsets[k] != 0 {
+			fbutils.SetUOffsetTSlot(fbb, slot, offsets[k])
+			continue
+		}
+
+		var value = obj[property.name]
+		if value == nil {
+			continue
+		}
+
+		if err := property.setSlot(fbb, slot, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setSlot writes a scalar value to the FlatBuffers table
+func (property *property) setSlot(fbb *flatbuffers.Builder, slot int, value interface{}) error {
+	var unsigned = property.flags&C.OBXPropertyFlags_UNSIGNED != 0
+	var err error
+
+	switch property.typ {
+	case C.OBXPropertyType_Bool:
+		if b, ok := value.(bool); ok {
+			fbutils.SetBoolSlot(fbb, slot, b)
+		} else {
+			return property.typeError(value)
+		}
+	case C.OBXPropertyType_Byte, C.OBXPropertyType_Char:
+		var u uint64
+		var i int64
+		if unsigned {
+			if u, err = dynamicUint(value, 8); err == nil {
+				fbutils.SetUint8Slot(fbb, slot, uint8(u))
+			}
+		} else if i, err = dynamicInt(value, 8); err == nil {
+			fbutils.SetInt8Slot(fbb, slot, int8(i))
+		}
+	case C.OBXPropertyType_Short:
+		var u uint64
+		var i int64
+		if unsigned {
+			if u, err = dynamicUint(value, 16); err == nil {
+				fbutils.SetUint16Slot(fbb, slot, uint16(u))
+			}
+		} else if i, err = dynamicInt(value, 16); err == nil {
+			fbutils.SetInt16Slot(fbb, slot, int16(i))
+		}
+	case C.OBXPropertyType_Int:
+		var u uint64
+		var i int64
+		if unsigned {
+			if u, err = dynamicUint(value, 32); err == nil {
+				fbutils.SetUint32Slot(fbb, slot, uint32(u))
+			}
+		} else if i, err = dynamicInt(value, 32); err == nil {
+			fbutils.SetInt32Slot(fbb, slot, int32(i))
+		}
+	case C.OBXPropertyType_Long, C.OBXPropertyType_Date, C.OBXPropertyType_Relation:
+		var u uint64
+		var i int64
+		if unsigned || property.typ == C.OBXPropertyType_Relation {
+			if u, err = dynamicUint(value, 64); err == nil {
+				fbutils.SetUint64Slot(fbb, slot, u)
+			}
+		} else if i, err = dynamicInt(value, 64); err == nil {
+			fbutils.SetInt64Slot(fbb, slot, i)
+		}
+	case C.OBXPropertyType_Float:
+		var f float64
+		if f, err = dynamicFloat(value); err == nil {
+			fbutils.SetFloat32Slot(fbb, slot, float32(f))
+		}
+	case C.OBXPropertyType_Double:
+		var f float64
+		if f, err = dynamicFloat(value); err == nil {
+			fbutils.SetFloat64Slot(fbb, slot, f)
+		}
+	default:
+		return fmt.Errorf("unsupported type %d of property %s", property.typ, property.name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%s on property %s", err, property.name)
+	}
+	return nil
+}
+
+// Load constructs the object map from FlatBuffers
+func (binding *dynamicBinding) Load(ob *ObjectBox, bytes []byte) (interface{}, error) {
+	if len(bytes) == 0 { // sanity check, should "never" happen
+		return nil, fmt.Errorf("can't deserialize an object of type '%s' - no data received", binding.entity.name)
+	}
+
+	var table = &flatbuffers.Table{
+		Bytes: bytes,
+		Pos:   flatbuffers.GetUOffsetT(bytes),
+	}
+
+	var object = make(map[string]interface{}, len(binding.entity.properties))
+	for _, property := range binding.entity.properties {
+		var unsigned = property.flags&C.OBXPropertyFlags_UNSIGNED != 0
+		var offset = flatbuffers.VOffsetT(4 + 2*(property.id-1))
+		var value interface{}
+
+		// values not present in the FlatBuffers table (e.g. nil pointer fields in the generated code) are nil
+		if table.Offset(offset) == 0 {
+			object[property.name] = nil
+			continue
+		}
+
+		switch property.typ {
+		case C.OBXPropertyType_Bool:
+			value = fbutils.GetBoolSlot(table, offset)
+		case C.OBXPropertyType_Byte, C.OBXPropertyType_Char:
+			if unsigned {
+				value = fbutils.GetUint8Slot(table, offset)
+			} else {
+				value = fbutils.GetInt8Slot(table, offset)
+			}
+		case C.OBXPropertyType_Short:
+			if unsigned {
+				value = fbutils.GetUint16Slot(table, offset)
+			} else {
+				value = fbutils.GetInt16Slot(table, offset)
+			}
+		case C.OBXPropertyType_Int:
+			if unsigned {
+				value = fbutils.GetUint32Slot(table, offset)
+			} else {
+				value = fbutils.GetInt32Slot(table, offset)
+			}
+		case C.OBXPropertyType_Long, C.OBXPropertyType_Date, C.OBXPropertyType_Relation:
+			if unsigned || property.typ == C.OBXPropertyType_Relation || property.id == binding.entity.idProperty {
+				value = fbutils.GetUint64Slot(table, offset)
+			} else {
+				value = fbutils.GetInt64Slot(table, offset)
+			}
+		case C.OBXPropertyType_Float:
+			value = fbutils.GetFloat32Slot(table, offset)
+		case C.OBXPropertyType_Double:
+			value = fbutils.GetFloat64Slot(table, offset)
+		case C.OBXPropertyType_String:
+			value = fbutils.GetStringSlot(table, offset)
+		case C.OBXPropertyType_ByteVector:
+			value = fbutils.GetByteVectorSlot(table, offset)
+		case C.OBXPropertyType_StringVector:
+			value = fbutils.GetStringVectorSlot(table, offset)
+		default:
+			return nil, fmt.Errorf("unsupported type %d of property %s", property.typ, property.name)
+		}
+
+		object[property.name] = value
+	}
+
+	return object, nil
+}
+
+// MakeSlice creates a slice of object maps
+func (binding *dynamicBinding) MakeSlice(capacity int) interface{} {
+	return make([]map[string]interface{}, 0, capacity)
+}
+
+// AppendToSlice adds the object map at the end of the slice
+func (binding *dynamicBinding) AppendToSlice(slice interface{}, object interface{}) interface{} {
+	if object == nil {
+		return append(slice.([]map[string]interface{}), nil)
+	}
+	return append(slice.([]map[string]interface{}), object.(map[string]interface{}))
+}
+
+// GeneratorVersion returns the current version - there's no generated code involved
+func (binding *dynamicBinding) GeneratorVersion() int {
+	return generator.Version
+}
+
+// queryProperty creates a query-building property for the property type, i.e. PropertyString, PropertyInt64, etc.
+func (property *property) queryProperty(entity *entity) (interface{}, error) {
+	var base = &BaseProperty{Id: property.id, Entity: &Entity{Id: entity.id}}
+	var unsigned = property.flags&C.OBXPropertyFlags_UNSIGNED != 0
+
+	switch property.typ {
+	case C.OBXPropertyType_Bool:
+		return &PropertyBool{base}, nil
+	case C.OBXPropertyType_Byte, C.OBXPropertyType_Char:
+		if unsigned {
+			return &PropertyUint8{base}, nil
+		}
+		return &PropertyInt8{base}, nil
+	case C.OBXPropertyType_Short:
+		if unsigned {
+			return &PropertyUint16{base}, nil
+		}
+		return &PropertyInt16{base}, nil
+	case C.OBXPropertyType_Int:
+		if unsigned {
+			return &PropertyUint32{base}, nil
+		}
+		return &PropertyInt32{base}, nil
+	case C.OBXPropertyType_Long, C.OBXPropertyType_Date, C.OBXPropertyType_Relation:
+		if unsigned || property.typ == C.OBXPropertyType_Relation || property.id == entity.idProperty {
+			return &PropertyUint64{base}, nil
+		}
+		return &PropertyInt64{base}, nil
+	case C.OBXPropertyType_Float:
+		return &PropertyFloat32{base}, nil
+	case C.OBXPropertyType_Double:
+		return &PropertyFloat64{base}, nil
+	case C.OBXPropertyType_String:
+		return &PropertyString{base}, nil
+	case C.OBXPropertyType_ByteVector:
+		return &PropertyByteVector{base}, nil
+	case C.OBXPropertyType_StringVector:
+		return &PropertyStringVector{base}, nil
+	}
+	return nil, fmt.Errorf("unsupported type %d of property %s", property.typ, property.name)
+}
+
+func (property *property) typeError(value interface{}) error {
+	return fmt.Errorf("unsupported value type %T for property %s of type %d", value, property.name, property.typ)
+}
+
+// dynamicInt converts any numeric value to int64, checking it fits into a signed integer of the given size
+func dynamicInt(value interface{}, bits uint) (int64, error) {
+	var result int64
+	var v = reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("value %v overflows int%d", value, bits)
+		}
+		result = int64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		if v.Float() != math.Trunc(v.Float()) || v.Float() < math.MinInt64 || v.Float() >= math.MaxInt64 {
+			return 0, fmt.Errorf("value %v can't be represented as int%d", value, bits)
+		}
+		result = int64(v.Float())
+	default:
+		return 0, fmt.Errorf("unsupported value type %T, expected a number", value)
+	}
+
+	if bits < 64 && (result < -1<<(bits-1) || result > 1<<(bits-1)-1) {
+		return 0, fmt.Errorf("value %v overflows int%d", value, bits)
+	}
+	return result, nil
+}
+
+// dynamicUint converts any numeric value to uint64, checking it fits into an unsigned integer of the given size
+func dynamicUint(value interface{}, bits uint) (uint64, error) {
+	var result uint64
+	var v = reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() < 0 {
+			return 0, fmt.Errorf("value %v overflows uint%d", value, bits)
+		}
+		result = uint64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		result = v.Uint()
+	case reflect.Float32, reflect.Float64:
+		if v.Float() != math.Trunc(v.Float()) || v.Float() < 0 || v.Float() >= math.MaxUint64 {
+			return 0, fmt.Errorf("value %v can't be represented as uint%d", value, bits)
+		}
+		result = uint64(v.Float())
+	default:
+		return 0, fmt.Errorf("unsupported value type %T, expected a number", value)
+	}
+
+	if bits < 64 && result > 1<<bits-1 {
+		return 0, fmt.Errorf("value %v overflows uint%d", value, bits)
+	}
+	return result, nil
+}
+
+// dynamicFloat converts any numeric value to float64
+func dynamicFloat(value interface{}) (float64, error) {
+	var v = reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	default:
+		return 0, fmt.Errorf("unsupported value type %T, expected a number", value)
+	}
+}
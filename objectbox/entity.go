@@ -29,6 +29,30 @@ type entity struct {
 	name      string
 	binding   ObjectBinding
 
+	// ID property of the entity - configured during model creation
+	idProperty TypeId
+
+	// all properties in the order of declaration - configured during model creation
+	properties []*property
+
 	// whether this entity has any relations (standalone or property-rels) - configured during model creation
 	hasRelations bool
 }
+
+// property holds information about an entity property, as declared in the model
+type property struct {
+	name  string
+	id    TypeId
+	typ   int
+	flags int
+}
+
+// propertyById returns the property with the given ID or nil if the entity doesn't have such property
+func (entity *entity) propertyById(id TypeId) *property {
+	for _, property := range entity.properties {
+		if property.id == id {
+			return property
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include "objectbox.h"
+*/
+import "C"
+import (
+	"errors"
+)
+
+// Error is returned by operations that failed in the ObjectBox core, carrying the native error codes.
+// On Go 1.13+, use errors.Is() with one of the Err* variables to check for a specific kind of error, e.g.:
+//
+//	if _, err := box.Put(object); errors.Is(err, objectbox.ErrUniqueViolation) {
+//		... // handle the duplicate
+//	}
+//
+// or errors.As() to access the error codes. On older Go versions, use a type assertion and call Is() directly:
+//
+//	if obxErr, ok := err.(*objectbox.Error); ok && obxErr.Is(objectbox.ErrUniqueViolation) {
+//		... // handle the duplicate
+//	}
+type Error struct {
+	// Code is the native error code, e.g. 10201 for a unique constraint violation
+	Code int
+
+	// SecondaryCode is the underlying, possibly platform-specific, error code; zero if not available
+	SecondaryCode int
+
+	// Message describes the error
+	Message string
+}
+
+// Error implements the error interface
+func (err *Error) Error() string {
+	return err.Message
+}
+
+// Is reports whether the given target is the Err* variable corresponding to the error code; used by errors.Is()
+func (err *Error) Is(target error) bool {
+	return target != nil && errorsByCode[err.Code] == target
+}
+
+// Errors that can be matched using errors.Is(), each corresponding to a native error code
+var (
+	// ErrNotFound is returned when the requested item (e.g. an object) doesn't exist
+	ErrNotFound = errors.New("not found")
+
+	// ErrIllegalState is returned when an operation is executed in an illegal state, e.g. on a closed query
+	ErrIllegalState = errors.New("illegal state")
+
+	// ErrIllegalArgument is returned when an operation is called with an illegal argument
+	ErrIllegalArgument = errors.New("illegal argument")
+
+	// ErrAllocation is returned when memory allocation failed
+	ErrAllocation = errors.New("allocation failed")
+
+	// ErrNumericOverflow is returned when a result doesn't fit into its type, e.g. a sum of large integers
+	ErrNumericOverflow = errors.New("numeric overflow")
+
+	// ErrDbFull is returned when the database size limit has been reached, see Builder.MaxSizeInKb()
+	ErrDbFull = errors.New("database full")
+
+	// ErrMaxReadersExceeded is returned when there are too many concurrent readers, see Builder.MaxReaders()
+	ErrMaxReadersExceeded = errors.New("maximum number of readers exceeded")
+
+	// ErrStoreMustShutdown is returned when the store has encountered an error and must be closed
+	ErrStoreMustShutdown = errors.New("store must shut down")
+
+	// ErrStorageGeneral is returned on a general storage error; see Error.SecondaryCode for the underlying cause
+	ErrStorageGeneral = errors.New("storage error")
+
+	// ErrUniqueViolation is returned when a unique constraint would be violated, e.g. on put
+	ErrUniqueViolation = errors.New("unique constraint violation")
+
+	// ErrNonUniqueResult is returned when a single result was expected but multiple were found
+	ErrNonUniqueResult = errors.New("non-unique result")
+
+	// ErrPropertyTypeMismatch is returned when an operation doesn't support the type of the given property
+	ErrPropertyTypeMismatch = errors.New("property type mismatch")
+
+	// ErrIdAlreadyExists is returned when inserting an object with an ID that already exists
+	ErrIdAlreadyExists = errors.New("ID already exists")
+
+	// ErrIdNotFound is returned when updating an object with an ID that doesn't exist
+	ErrIdNotFound = errors.New("ID not found")
+
+	// ErrConstraintViolation is returned when a (non-unique) constraint would be violated
+	ErrConstraintViolation = errors.New("constraint violation")
+
+	// ErrSchema is returned when the model doesn't match the database schema, e.g. after an incompatible change
+	ErrSchema = errors.New("schema error")
+
+	// ErrFileCorrupt is returned when the database file is corrupt
+	ErrFileCorrupt = errors.New("file corrupt")
+)
+
+var errorsByCode = map[int]error{
+	C.OBX_NOT_FOUND:                    ErrNotFound,
+	C.OBX_ERROR_ILLEGAL_STATE:          ErrIllegalState,
+	C.OBX_ERROR_ILLEGAL_ARGUMENT:       ErrIllegalArgument,
+	C.OBX_ERROR_ALLOCATION:             ErrAllocation,
+	C.OBX_ERROR_NUMERIC_OVERFLOW:       ErrNumericOverflow,
+	C.OBX_ERROR_DB_FULL:                ErrDbFull,
+	C.OBX_ERROR_MAX_READERS_EXCEEDED:   ErrMaxReadersExceeded,
+	C.OBX_ERROR_STORE_MUST_SHUTDOWN:    ErrStoreMustShutdown,
+	C.OBX_ERROR_STORAGE_GENERAL:        ErrStorageGeneral,
+	C.OBX_ERROR_UNIQUE_VIOLATED:        ErrUniqueViolation,
+	C.OBX_ERROR_NON_UNIQUE_RESULT:      ErrNonUniqueResult,
+	C.OBX_ERROR_PROPERTY_TYPE_MISMATCH: ErrPropertyTypeMismatch,
+	C.OBX_ERROR_ID_ALREADY_EXISTS:      ErrIdAlreadyExists,
+	C.OBX_ERROR_ID_NOT_FOUND:           ErrIdNotFound,
+	C.OBX_ERROR_CONSTRAINT_VIOLATED:    ErrConstraintViolation,
+	C.OBX_ERROR_SCHEMA:                 ErrSchema,
+	C.OBX_ERROR_FILE_CORRUPT:           ErrFileCorrupt,
+}
+
+// newIllegalStateError creates an error for illegal states detected on the Go side, matching ErrIllegalState
+func newIllegalStateError(message string) error {
+	return &Error{Code: C.OBX_ERROR_ILLEGAL_STATE, Message: message}
+}
@@ -0,0 +1,379 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// BoxOf provides type-safe CRUD access to objects of type T, without the need for per-entity generated Box wrappers.
+// T is the entity struct type, e.g. BoxOf[Task] works with *Task objects.
+// The entity binding of T must have been registered in the model (i.e. the generated code is still required).
+type BoxOf[T any] struct {
+	*Box
+}
+
+// BoxFor opens a box of T objects.
+// Note: this function panics if T isn't an entity in the model, use BoxForOrError if you want the explicit error check.
+func BoxFor[T any](ob *ObjectBox) *BoxOf[T] {
+	box, err := BoxForOrError[T](ob)
+	if err != nil {
+		panic(err)
+	}
+	return box
+}
+
+// BoxForOrError opens a box of T objects or returns an error if T isn't an entity in the model.
+func BoxForOrError[T any](ob *ObjectBox) (*BoxOf[T], error) {
+	entityId, err := entityIdOf[T](ob)
+	if err != nil {
+		return nil, err
+	}
+
+	box, err := ob.box(entityId)
+	if err != nil {
+		return nil, err
+	}
+	return &BoxOf[T]{Box: box}, nil
+}
+
+// entityIdOf finds the entity whose binding works with T objects, i.e. creates []*T or []T slices
+func entityIdOf[T any](ob *ObjectBox) (TypeId, error) {
+	var ptrType = reflect.TypeOf((*T)(nil))
+	for id, entity := range ob.entitiesById {
+		var elemType = reflect.TypeOf(entity.binding.MakeSlice(0)).Elem()
+		if elemType == ptrType || elemType == ptrType.Elem() {
+			return id, nil
+		}
+	}
+	return 0, fmt.Errorf("type %s is not an entity in the model", ptrType.Elem())
+}
+
+// typedSlice converts objects read by a box or a query to []*T.
+// Bindings generated with the "byValue" option create []T, in which case a slice of pointers to its items is returned.
+func typedSlice[T any](objects interface{}) []*T {
+	switch slice := objects.(type) {
+	case []*T:
+		return slice
+	case []T:
+		var result = make([]*T, len(slice))
+		for k := range slice {
+			result[k] = &slice[k]
+		}
+		return result
+	}
+	panic(fmt.Sprintf("unexpected slice type %T, expected []*%s", objects, reflect.TypeOf((*T)(nil)).Elem()))
+}
+
+// WithTx returns a copy of the box bound to the given transaction, see Tx for more details.
+func (box *BoxOf[T]) WithTx(tx *Tx) *BoxOf[T] {
+	return &BoxOf[T]{Box: box.Box.WithTx(tx)}
+}
+
+// Put synchronously inserts/updates a single object.
+// In case the ID is not specified, it would be assigned automatically (auto-increment).
+// When inserting, the ID property on the passed object will be assigned the new ID as well.
+func (box *BoxOf[T]) Put(object *T) (uint64, error) {
+	return box.Box.Put(object)
+}
+
+// Insert synchronously inserts a single object. As opposed to Put, Insert will fail if given an ID that already exists.
+// In case the ID is not specified, it would be assigned automatically (auto-increment).
+// When inserting, the ID property on the passed object will be assigned the new ID as well.
+func (box *BoxOf[T]) Insert(object *T) (uint64, error) {
+	return box.Box.Insert(object)
+}
+
+// Update synchronously updates a single object.
+// As opposed to Put, Update will fail if an object with the same ID is not found in the database.
+func (box *BoxOf[T]) Update(object *T) error {
+	return box.Box.Update(object)
+}
+
+// PutMany inserts multiple objects in single transaction.
+// In case IDs are not set on the objects, they would be assigned automatically (auto-increment).
+//
+// Returns: IDs of the put objects (in the same order).
+// When inserting, the ID property on the objects in the slice will be assigned the new IDs as well.
+//
+// Note: The slice may be empty or even nil; in both cases, an empty IDs slice and no error is returned.
+func (box *BoxOf[T]) PutMany(objects []*T) ([]uint64, error) {
+	return box.Box.PutMany(objects)
+}
+
+// PutManyContext is like PutMany but stops processing the objects when the context is done, see Box.PutManyContext().
+func (box *BoxOf[T]) PutManyContext(ctx context.Context, objects []*T) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
+// Get reads a single object.
+//
+// Returns nil (and no error) in case the object with the given ID doesn't exist.
+func (box *BoxOf[T]) Get(id uint64) (*T, error) {
+	object, err := box.Box.Get(id)
+	if err != nil {
+		return nil, err
+	} else if object == nil {
+		return nil, nil
+	}
+	return object.(*T), nil
+}
+
+// GetMany reads multiple objects at once.
+// If any of the objects doesn't exist, its position in the return slice is nil
+// (or points to a zero value for bindings generated with the "byValue" option)
+func (box *BoxOf[T]) GetMany(ids ...uint64) ([]*T, error) {
+	objects, err := box.Box.GetMany(ids...)
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// GetManyExisting reads multiple objects at once, skipping those that do not exist.
+func (box *BoxOf[T]) GetManyExisting(ids ...uint64) ([]*T, error) {
+	objects, err := box.Box.GetManyExisting(ids...)
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// GetAll reads all stored objects
+func (box *BoxOf[T]) GetAll() ([]*T, error) {
+	objects, err := box.Box.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *BoxOf[T]) GetAllContext(ctx context.Context) ([]*T, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// ForEach calls fn for each stored object, ordered by their IDs, until it returns false or an error.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *BoxOf[T]) ForEach(fn func(*T) (bool, error)) error {
+	return box.Box.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*T))
+	})
+}
+
+// Remove deletes a single object
+func (box *BoxOf[T]) Remove(object *T) error {
+	return box.Box.Remove(object)
+}
+
+// RemoveMany deletes multiple objects at once.
+// Returns the number of deleted object or error on failure.
+// Note that this method will not fail if an object is not found (e.g. already removed).
+func (box *BoxOf[T]) RemoveMany(objects ...*T) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		id, err := box.entity.binding.GetId(object)
+		if err != nil {
+			return 0, err
+		}
+		ids[k] = id
+	}
+	return box.Box.RemoveIds(ids...)
+}
+
+// Query creates a query with the given conditions. Use the fields of the generated Entity_ struct to create conditions.
+// Keep the *QueryOf[T] if you intend to execute the query multiple times.
+// Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
+// This is typically a programming error. Use QueryOrError instead if you want the explicit error check.
+func (box *BoxOf[T]) Query(conditions ...Condition) *QueryOf[T] {
+	return &QueryOf[T]{Query: box.Box.Query(conditions...)}
+}
+
+// QueryOrError creates a query with the given conditions. Use the fields of the generated Entity_ struct to create conditions.
+// Keep the *QueryOf[T] if you intend to execute the query multiple times.
+func (box *BoxOf[T]) QueryOrError(conditions ...Condition) (*QueryOf[T], error) {
+	query, err := box.Box.QueryOrError(conditions...)
+	if err != nil {
+		return nil, err
+	}
+	return &QueryOf[T]{Query: query}, nil
+}
+
+// Async provides access to the default Async Box for asynchronous operations. See AsyncBox for more information.
+func (box *BoxOf[T]) Async() *AsyncBoxOf[T] {
+	return &AsyncBoxOf[T]{AsyncBox: box.Box.Async()}
+}
+
+// AsyncBoxOf provides type-safe asynchronous operations on T objects, see AsyncBox for more information.
+type AsyncBoxOf[T any] struct {
+	*AsyncBox
+}
+
+// NewAsyncBoxOf creates a new async box of T objects with the given operation timeout in case an async queue is full.
+// The returned struct must be freed explicitly using the Close() method.
+// It's usually preferable to use BoxOf[T].Async() which takes care of resource management and doesn't require closing.
+func NewAsyncBoxOf[T any](ob *ObjectBox, timeoutMs uint64) (*AsyncBoxOf[T], error) {
+	entityId, err := entityIdOf[T](ob)
+	if err != nil {
+		return nil, err
+	}
+
+	async, err := NewAsyncBox(ob, entityId, timeoutMs)
+	if err != nil {
+		return nil, err
+	}
+	return &AsyncBoxOf[T]{AsyncBox: async}, nil
+}
+
+// Put inserts/updates a single object asynchronously.
+// When inserting a new object, the ID property on the passed object will be assigned the new ID the entity would hold
+// if the insert is ultimately successful. The newly assigned ID may not become valid if the insert fails.
+func (async *AsyncBoxOf[T]) Put(object *T) (uint64, error) {
+	return async.AsyncBox.Put(object)
+}
+
+// Insert a single object asynchronously.
+// The ID property on the passed object will be assigned the new ID the entity would hold if the insert is ultimately
+// successful. The newly assigned ID may not become valid if the insert fails.
+// Fails silently if an object with the same ID already exists (this error is not returned).
+func (async *AsyncBoxOf[T]) Insert(object *T) (uint64, error) {
+	return async.AsyncBox.Insert(object)
+}
+
+// Update a single object asynchronously.
+// The object must already exists or the update fails silently (without an error returned).
+func (async *AsyncBoxOf[T]) Update(object *T) error {
+	return async.AsyncBox.Update(object)
+}
+
+// Remove deletes a single object asynchronously.
+func (async *AsyncBoxOf[T]) Remove(object *T) error {
+	return async.AsyncBox.Remove(object)
+}
+
+// QueryOf provides a type-safe way to search stored T objects, see BoxOf[T].Query()
+type QueryOf[T any] struct {
+	*Query
+}
+
+// Find returns all objects matching the query
+func (query *QueryOf[T]) Find() ([]*T, error) {
+	objects, err := query.Query.Find()
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// FindContext is like Find but stops reading the results when the context is done, returning ctx.Err().
+func (query *QueryOf[T]) FindContext(ctx context.Context) ([]*T, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return typedSlice[T](objects), nil
+}
+
+// FindFirst returns the first object matching the query or nil if there's none, see Query.FindFirst()
+func (query *QueryOf[T]) FindFirst() (*T, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*T), nil
+}
+
+// FindUnique returns the single object matching the query or nil if there's none, see Query.FindUnique()
+func (query *QueryOf[T]) FindUnique() (*T, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*T), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page,
+// see Query.Page()
+func (query *QueryOf[T]) Page(size uint64, afterToken string) ([]*T, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return typedSlice[T](objects), nextToken, nil
+}
+
+// Clone creates an independent copy of the query which can be used concurrently, see Query.Clone()
+func (query *QueryOf[T]) Clone() (*QueryOf[T], error) {
+	clone, err := query.Query.Clone()
+	if err != nil {
+		return nil, err
+	}
+	return &QueryOf[T]{Query: clone}, nil
+}
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false, see Query.ForEach()
+func (query *QueryOf[T]) ForEach(fn func(*T) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*T))
+	})
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result,
+// see Query.Filter()
+func (query *QueryOf[T]) Filter(fn func(*T) bool) *QueryOf[T] {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*T))
+		})
+	}
+	return query
+}
+
+// Offset defines the index of the first object to process (how many objects to skip)
+func (query *QueryOf[T]) Offset(offset uint64) *QueryOf[T] {
+	query.Query.Offset(offset)
+	return query
+}
+
+// Limit sets the number of elements to process by the query
+func (query *QueryOf[T]) Limit(limit uint64) *QueryOf[T] {
+	query.Query.Limit(limit)
+	return query
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *QueryOf[T]) Subscribe(fn func([]*T, error)) (*Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(typedSlice[T](objects), nil)
+		}
+	})
+}
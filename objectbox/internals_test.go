@@ -1,6 +1,7 @@
 package objectbox
 
 import (
+	"math"
 	"runtime"
 	"testing"
 )
@@ -18,3 +19,18 @@ func TestLargeArraySupport(t *testing.T) {
 			"in the ObjectBox core library", runtime.GOARCH)
 	}
 }
+
+func TestDynamicNumberBounds(t *testing.T) {
+	if _, err := dynamicInt(float64(math.MaxInt64), 64); err == nil {
+		t.Errorf("Expected 2^63 as a float to overflow int64")
+	}
+	if value, err := dynamicInt(float64(math.MinInt64), 64); err != nil || value != math.MinInt64 {
+		t.Errorf("Expected -2^63 as a float to fit into int64, got %v, %v", value, err)
+	}
+	if _, err := dynamicUint(float64(math.MaxUint64), 64); err == nil {
+		t.Errorf("Expected 2^64 as a float to overflow uint64")
+	}
+	if value, err := dynamicUint(float64(1<<63), 64); err != nil || value != 1<<63 {
+		t.Errorf("Expected 2^63 as a float to fit into uint64, got %v, %v", value, err)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// BoxIterator streams objects stored in a box one by one, without loading all of them into memory at once.
+// Use Box.Iterate() to create an iterator and ForEach() to execute it, e.g. to process all objects in reverse order:
+//
+//	box.Iterate().Reverse().ForEach(func(object interface{}) (bool, error) {
+//		... // process the object
+//		return true, nil // continue with the next object
+//	})
+type BoxIterator struct {
+	box     *Box
+	seekId  uint64
+	reverse bool
+}
+
+// Iterate creates an iterator going through all objects stored in the box, ordered by their IDs
+func (box *Box) Iterate() *BoxIterator {
+	return &BoxIterator{box: box}
+}
+
+// ForEach calls fn for each stored object, ordered by ID, until it returns false or an error.
+// The objects are read inside a single read transaction, so fn can't change data in the database.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *Box) ForEach(fn func(object interface{}) (bool, error)) error {
+	return box.Iterate().ForEach(fn)
+}
+
+// Seek makes the iteration start at the object with the given ID.
+// If there's no such object, the iteration starts with the next one in the iteration order.
+func (iterator *BoxIterator) Seek(id uint64) *BoxIterator {
+	iterator.seekId = id
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one.
+// Note: the objects are still read one by one but the IDs of all of them are looked up before the iteration starts.
+func (iterator *BoxIterator) Reverse() *BoxIterator {
+	iterator.reverse = true
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error.
+// The objects are read inside a single read transaction, so fn can't change data in the database.
+// If fn returns an error, the iteration stops and the error is returned.
+func (iterator *BoxIterator) ForEach(fn func(object interface{}) (bool, error)) (err error) {
+	var box = iterator.box
+	if err := box.checkTx(); err != nil {
+		return err
+	}
+
+	// cursors are bound to an explicit transaction - reuse the one the box is bound to or start a new one
+	var tx = box.tx
+	if tx == nil {
+		if tx, err = box.ObjectBox.Begin(true); err != nil {
+			return err
+		}
+
+		defer func() {
+			if err2 := tx.Commit(); err == nil {
+				err = err2
+			}
+		}()
+	}
+
+	// NOTE: no need for manual runtime.LockOSThread() in the rest of this function because we're inside a transaction
+	var cCursor = C.obx_cursor(tx.cTxn, C.obx_schema_id(box.entity.id))
+	if cCursor == nil {
+		return createError()
+	}
+	defer C.obx_cursor_close(cCursor)
+
+	if iterator.reverse {
+		return iterator.forEachReverse(cCursor, fn)
+	}
+	return iterator.forEachForward(cCursor, fn)
+}
+
+// forEachForward streams the objects from the cursor, starting at the first one or the one seeked to
+func (iterator *BoxIterator) forEachForward(cCursor *C.OBX_cursor, fn func(object interface{}) (bool, error)) error {
+	var data unsafe.Pointer
+	var dataSize C.size_t
+
+	var rc C.obx_err
+	if iterator.seekId == 0 {
+		rc = C.obx_cursor_first(cCursor, &data, &dataSize)
+	} else {
+		var err error
+		if rc, err = iterator.seek(cCursor, iterator.seekId); err != nil {
+			return err
+		} else if rc == 0 {
+			rc = C.obx_cursor_current(cCursor, &data, &dataSize)
+		}
+	}
+
+	for rc == 0 {
+		if next, err := iterator.visit(data, dataSize, fn); err != nil || !next {
+			return err
+		}
+		rc = C.obx_cursor_next(cCursor, &data, &dataSize)
+	}
+
+	if rc != C.OBX_NOT_FOUND {
+		return createError()
+	}
+	return nil
+}
+
+// forEachReverse reads the objects from the cursor one by one, from the highest ID (or the one seeked to) to the lowest.
+// The native cursor API can only go forward (there are no obx_cursor_prev() or obx_cursor_last() functions) so the IDs
+// are looked up first; they come in the ascending order from the database and are only visited backwards, not sorted.
+func (iterator *BoxIterator) forEachReverse(cCursor *C.OBX_cursor, fn func(object interface{}) (bool, error)) error {
+	var upper uint64 = maxId
+	if iterator.seekId > 0 && iterator.seekId < maxId {
+		upper = iterator.seekId
+	}
+
+	ids, err := iterator.findIds(cCursor, 1, upper, 0)
+	if err != nil {
+		return err
+	}
+
+	var data unsafe.Pointer
+	var dataSize C.size_t
+	for i := len(ids) - 1; i >= 0; i-- {
+		if rc := C.obx_cursor_get(cCursor, C.obx_id(ids[i]), &data, &dataSize); rc != 0 {
+			return createError()
+		}
+
+		if next, err := iterator.visit(data, dataSize, fn); err != nil || !next {
+			return err
+		}
+	}
+	return nil
+}
+
+// IDs are compared as int64 values by queries, keep the bounds within its positive range to avoid overflows
+const maxId = math.MaxInt64
+
+// seek positions the cursor at the object with the given ID or, if there's no such object, the next one.
+// Returns OBX_NOT_FOUND if there are no objects with the given or a higher ID.
+func (iterator *BoxIterator) seek(cCursor *C.OBX_cursor, id uint64) (C.obx_err, error) {
+	if id > maxId {
+		return C.OBX_NOT_FOUND, nil
+	}
+
+	if rc := C.obx_cursor_seek(cCursor, C.obx_id(id)); rc != C.OBX_NOT_FOUND {
+		return rc, nil
+	}
+
+	ids, err := iterator.findIds(cCursor, id, maxId, 1)
+	if err != nil || len(ids) == 0 {
+		return C.OBX_NOT_FOUND, err
+	}
+	return C.obx_cursor_seek(cCursor, C.obx_id(ids[0])), nil
+}
+
+// findIds looks up IDs of the objects within the given range (inclusive) using the cursor, in ascending order
+func (iterator *BoxIterator) findIds(cCursor *C.OBX_cursor, from, to uint64, limit uint64) ([]uint64, error) {
+	var box = iterator.box
+	if box.entity.idProperty == 0 {
+		return nil, fmt.Errorf("ID property of entity %s is unknown", box.entity.name)
+	}
+
+	var idProperty = PropertyUint64{&BaseProperty{Id: box.entity.idProperty, Entity: &Entity{Id: box.entity.id}}}
+	query, err := box.QueryOrError(idProperty.Between(from, to))
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	return cGetIds(func() *C.OBX_id_array {
+		return C.obx_query_cursor_find_ids(query.cQuery, cCursor, 0, C.uint64_t(limit))
+	})
+}
+
+// visit loads the object from the data read by the cursor and passes it to fn
+func (iterator *BoxIterator) visit(data unsafe.Pointer, dataSize C.size_t, fn func(object interface{}) (bool, error)) (bool, error) {
+	var bytes []byte
+	cVoidPtrToByteSlice(data, int(dataSize), &bytes)
+
+	object, err := iterator.box.entity.binding.Load(iterator.box.ObjectBox, bytes)
+	if err != nil {
+		return false, err
+	}
+	return fn(object)
+}
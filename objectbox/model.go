@@ -65,9 +65,10 @@ type Model struct {
 	cModel *C.OBX_model
 	Error  error
 
-	currentEntity  *entity
-	entitiesById   map[TypeId]*entity
-	entitiesByName map[string]*entity
+	currentEntity   *entity
+	currentProperty TypeId
+	entitiesById    map[TypeId]*entity
+	entitiesByName  map[string]*entity
 
 	lastEntityId  TypeId
 	lastEntityUid uint64
@@ -198,6 +199,13 @@ func (model *Model) Property(name string, propertyType int, id TypeId, uid uint6
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_property(model.cModel, cname, C.OBXPropertyType(propertyType), C.obx_schema_id(id), C.obx_uid(uid))
 	})
+
+	model.currentProperty = id
+	model.currentEntity.properties = append(model.currentEntity.properties, &property{
+		name: name,
+		id:   id,
+		typ:  propertyType,
+	})
 }
 
 // PropertyFlags configures type and other information about the property
@@ -208,6 +216,14 @@ func (model *Model) PropertyFlags(propertyFlags int) {
 	model.Error = cCall(func() C.obx_err {
 		return C.obx_model_property_flags(model.cModel, C.OBXPropertyFlags(propertyFlags))
 	})
+
+	if propertyFlags&C.OBXPropertyFlags_ID != 0 {
+		model.currentEntity.idProperty = model.currentProperty
+	}
+
+	if count := len(model.currentEntity.properties); count > 0 {
+		model.currentEntity.properties[count-1].flags = propertyFlags
+	}
 }
 
 // PropertyIndex creates a new index on the property
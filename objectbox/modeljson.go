@@ -0,0 +1,143 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/objectbox/objectbox-go/internal/generator"
+	"github.com/objectbox/objectbox-go/internal/generator/modelinfo"
+)
+
+// jsonBinding adds an entity from a model JSON file to the model; objects are represented as maps, see DynamicBox
+type jsonBinding struct {
+	*dynamicBinding
+	info *modelinfo.Entity
+}
+
+// AddToModel declares the entity, its properties and relations, as read from the model JSON file
+func (binding *jsonBinding) AddToModel(model *Model) {
+	if err := binding.addToModel(model); err != nil && model.Error == nil {
+		model.Error = fmt.Errorf("invalid entity %s: %s", binding.info.Name, err)
+	}
+}
+
+func (binding *jsonBinding) addToModel(model *Model) error {
+	var info = binding.info
+
+	id, uid, err := info.Id.Get()
+	if err != nil {
+		return err
+	}
+	model.Entity(info.Name, TypeId(id), uid)
+
+	for _, property := range info.Properties {
+		if id, uid, err = property.Id.Get(); err != nil {
+			return fmt.Errorf("property %s: %s", property.Name, err)
+		}
+		model.Property(property.Name, property.Type, TypeId(id), uid)
+
+		if property.Flags != 0 {
+			model.PropertyFlags(property.Flags)
+		}
+
+		if property.IndexId != nil {
+			if id, uid, err = property.IndexId.Get(); err != nil {
+				return fmt.Errorf("property %s index: %s", property.Name, err)
+			}
+
+			if len(property.RelationTarget) > 0 {
+				model.PropertyRelation(property.RelationTarget, TypeId(id), uid)
+			} else {
+				model.PropertyIndex(TypeId(id), uid)
+			}
+		}
+	}
+
+	if id, uid, err = info.LastPropertyId.Get(); err != nil {
+		return fmt.Errorf("lastPropertyId: %s", err)
+	}
+	model.EntityLastPropertyId(TypeId(id), uid)
+
+	for _, relation := range info.Relations {
+		if id, uid, err = relation.Id.Get(); err != nil {
+			return fmt.Errorf("relation %s: %s", relation.Name, err)
+		}
+
+		targetId, targetUid, err := relation.TargetId.Get()
+		if err != nil {
+			return fmt.Errorf("relation %s target: %s", relation.Name, err)
+		}
+		model.Relation(TypeId(id), uid, TypeId(targetId), targetUid)
+	}
+
+	return model.Error
+}
+
+// modelFromJSONFile creates a model from a JSON file in the format created by the generator (objectbox-model.json)
+func modelFromJSONFile(path string) (*Model, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var info = &modelinfo.ModelInfo{}
+	if err = json.Unmarshal(data, info); err != nil {
+		return nil, fmt.Errorf("can't parse model JSON file %s: %s", path, err)
+	}
+
+	if err = info.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model JSON file %s: %s", path, err)
+	}
+
+	var model = NewModel()
+	model.GeneratorVersion(generator.Version)
+
+	for _, entityInfo := range info.Entities {
+		var binding = &jsonBinding{dynamicBinding: &dynamicBinding{}, info: entityInfo}
+		model.RegisterBinding(binding)
+		if model.Error != nil {
+			return nil, model.Error
+		}
+		binding.entity = model.entitiesByName[entityInfo.Name]
+	}
+
+	id, uid, err := info.LastEntityId.Get()
+	if err != nil {
+		return nil, fmt.Errorf("invalid lastEntityId in model JSON file %s: %s", path, err)
+	}
+	model.LastEntityId(TypeId(id), uid)
+
+	// indexes and relations are optional
+	if len(info.LastIndexId) > 0 {
+		if id, uid, err = info.LastIndexId.Get(); err != nil {
+			return nil, fmt.Errorf("invalid lastIndexId in model JSON file %s: %s", path, err)
+		}
+		model.LastIndexId(TypeId(id), uid)
+	}
+
+	if len(info.LastRelationId) > 0 {
+		if id, uid, err = info.LastRelationId.Get(); err != nil {
+			return nil, fmt.Errorf("invalid lastRelationId in model JSON file %s: %s", path, err)
+		}
+		model.LastRelationId(TypeId(id), uid)
+	}
+
+	return model, model.Error
+}
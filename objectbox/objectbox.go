@@ -24,6 +24,7 @@ package objectbox
 import "C"
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"strconv"
@@ -77,6 +78,8 @@ type ObjectBox struct {
 	entitiesByName map[string]*entity
 	boxes          map[TypeId]*Box
 	boxesMutex     sync.Mutex
+	observers      map[uint32]*Observer
+	observersMutex sync.Mutex
 	options        options
 }
 
@@ -90,6 +93,10 @@ var supportsBytesArray = bool(C.obx_supports_bytes_array())
 // Close fully closes the database and frees resources
 func (ob *ObjectBox) Close() {
 	storeToClose := ob.store
+	if storeToClose != nil {
+		// observers reference the native store so they must be closed first
+		ob.closeObservers()
+	}
 	ob.store = nil
 	if storeToClose != nil {
 		C.obx_store_close(storeToClose)
@@ -115,6 +122,31 @@ func (ob *ObjectBox) RunInWriteTx(fn func() error) error {
 	return ob.runInTxn(false, fn)
 }
 
+// RunInReadTxContext is like RunInReadTx but doesn't start the transaction if the context is already done.
+// If the context is done by the time fn finishes, ctx.Err() is returned.
+func (ob *ObjectBox) RunInReadTxContext(ctx context.Context, fn func() error) error {
+	return ob.runInTxnContext(ctx, true, fn)
+}
+
+// RunInWriteTxContext is like RunInWriteTx but doesn't start the transaction if the context is already done.
+// If the context is done by the time fn finishes, the transaction is aborted (rolled-back) and ctx.Err() is returned.
+func (ob *ObjectBox) RunInWriteTxContext(ctx context.Context, fn func() error) error {
+	return ob.runInTxnContext(ctx, false, fn)
+}
+
+func (ob *ObjectBox) runInTxnContext(ctx context.Context, readOnly bool, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return ob.runInTxn(readOnly, func() error {
+		if err := fn(); err != nil {
+			return err
+		}
+		return ctx.Err()
+	})
+}
+
 func (ob *ObjectBox) runInTxn(readOnly bool, fn func() error) (err error) {
 	// NOTE if runtime.LockOSThread() is about to be removed, evaluate use of createError() inside transactions
 	runtime.LockOSThread()
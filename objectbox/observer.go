@@ -0,0 +1,275 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+This file implements obx_observer forwarding to Go observers
+
+Overview:
+	* Create an Observer, registering it under an observer ID.
+	* Pass the ID (allocated in C memory) together with a generic observer (C.observerDispatch) to C.obx_observe().
+	* When ObjectBox calls observerDispatch after a commit, it finds the observer registered under that ID
+	  and queues the changed entity type IDs, which are then delivered on the observer's own goroutine.
+	* When the observer is closed, the native observer is closed first and only then the ID is unregistered.
+*/
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+
+// this implements the obx_observer forwarding, it's called from ObjectBox C-api (see `observerCallback` go var)
+extern void observerDispatch(void* observerId, void* typeIds, int typeIdsCount);
+*/
+import "C"
+import (
+	"fmt"
+	"sync"
+	"unsafe"
+)
+
+// Observer delivers notifications about committed data changes, see ObjectBox.Subscribe().
+//
+// Notifications are delivered on a separate goroutine so that the committing thread is never blocked.
+// If notifications arrive faster than they're consumed, they're merged together.
+// Always call Close() when the observer is no longer needed.
+type Observer struct {
+	// C delivers IDs of the entity types changed by a committed transaction.
+	// It's closed after the observer is closed. C is nil if the observer has been created using a callback function.
+	C <-chan []TypeId
+
+	objectBox *ObjectBox
+	cObserver *C.OBX_observer
+	cId       *C.uint32_t
+	id        uint32
+	entityIds map[TypeId]bool // empty if all types are observed
+	fn        func(entityIds []TypeId)
+	closed    func()
+
+	mutex   sync.Mutex
+	pending []TypeId
+	signal  chan struct{}
+	done    chan struct{}
+}
+
+var observerCallback = (*C.obx_observer)(unsafe.Pointer(C.observerDispatch))
+var observerId uint32
+var observerMutex sync.Mutex
+var observers = make(map[uint32]*Observer)
+
+// Subscribe creates an observer receiving notifications on its channel C after each committed write transaction
+// changing objects of the given entity types. If no entity IDs are given, changes of all types are observed.
+func (ob *ObjectBox) Subscribe(entityIds ...TypeId) (*Observer, error) {
+	var ch = make(chan []TypeId, 1)
+	observer, err := ob.subscribe(entityIds, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	observer.C = ch
+	observer.fn = func(ids []TypeId) {
+		select {
+		case ch <- ids:
+		case <-observer.done:
+		}
+	}
+	observer.closed = func() {
+		close(ch)
+	}
+
+	go observer.run()
+	return observer, nil
+}
+
+// SubscribeFunc is like Subscribe() but calls the given function instead of sending to a channel.
+// The function is called on a separate goroutine, one call at a time.
+func (ob *ObjectBox) SubscribeFunc(fn func(entityIds []TypeId), entityIds ...TypeId) (*Observer, error) {
+	if fn == nil {
+		return nil, fmt.Errorf("observer callback function must not be nil")
+	}
+
+	observer, err := ob.subscribe(entityIds, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	go observer.run()
+	return observer, nil
+}
+
+func (ob *ObjectBox) subscribe(entityIds []TypeId, fn func([]TypeId)) (*Observer, error) {
+	var observer = &Observer{
+		objectBox: ob,
+		entityIds: make(map[TypeId]bool),
+		fn:        fn,
+		closed:    func() {},
+		signal:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+
+	for _, entityId := range entityIds {
+		// panics if the entity is not registered, same as InternalBox()
+		ob.getEntityById(entityId)
+		observer.entityIds[entityId] = true
+	}
+
+	if err := observerRegister(observer); err != nil {
+		return nil, err
+	}
+
+	// the ID must live in C memory as it's kept by the native observer
+	observer.cId = (*C.uint32_t)(C.malloc(C.size_t(unsafe.Sizeof(C.uint32_t(0)))))
+	*observer.cId = C.uint32_t(observer.id)
+
+	if err := cCallBool(func() bool {
+		observer.cObserver = C.obx_observe(ob.store, observerCallback, unsafe.Pointer(observer.cId))
+		return observer.cObserver != nil
+	}); err != nil {
+		observerUnregister(observer.id)
+		C.free(unsafe.Pointer(observer.cId))
+		return nil, err
+	}
+
+	ob.observersMutex.Lock()
+	ob.observers[observer.id] = observer
+	ob.observersMutex.Unlock()
+
+	return observer, nil
+}
+
+// Close stops the observer. No notifications are delivered after Close() returns, except for a callback that may be
+// running at the time. It's safe to call Close() multiple times, as well as from inside the observer's callback.
+func (observer *Observer) Close() {
+	observer.mutex.Lock()
+	var cObserver = observer.cObserver
+	observer.cObserver = nil
+	observer.mutex.Unlock()
+
+	if cObserver == nil {
+		return
+	}
+
+	C.obx_observer_close(cObserver)
+	observerUnregister(observer.id)
+	C.free(unsafe.Pointer(observer.cId))
+	observer.cId = nil
+
+	observer.objectBox.observersMutex.Lock()
+	delete(observer.objectBox.observers, observer.id)
+	observer.objectBox.observersMutex.Unlock()
+
+	close(observer.done)
+}
+
+// notify is called on the committing thread so it must not block
+func (observer *Observer) notify(entityIds []TypeId) {
+	observer.mutex.Lock()
+	for _, entityId := range entityIds {
+		if len(observer.entityIds) > 0 && !observer.entityIds[entityId] {
+			continue
+		}
+
+		var found = false
+		for _, pending := range observer.pending {
+			if pending == entityId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			observer.pending = append(observer.pending, entityId)
+		}
+	}
+	var hasPending = len(observer.pending) > 0
+	observer.mutex.Unlock()
+
+	if hasPending {
+		select {
+		case observer.signal <- struct{}{}:
+		default: // already signalled, the pending IDs will be picked up
+		}
+	}
+}
+
+// run delivers notifications until the observer is closed
+func (observer *Observer) run() {
+	defer observer.closed()
+
+	for {
+		select {
+		case <-observer.done:
+			return
+		case <-observer.signal:
+		}
+
+		observer.mutex.Lock()
+		var entityIds = observer.pending
+		observer.pending = nil
+		observer.mutex.Unlock()
+
+		if len(entityIds) > 0 {
+			observer.fn(entityIds)
+		}
+	}
+}
+
+func observerRegister(observer *Observer) error {
+	observerMutex.Lock()
+	defer observerMutex.Unlock()
+
+	// cycle through ids until we find an empty slot
+	observerId++
+	var initialId = observerId
+	for observerId == 0 || observers[observerId] != nil {
+		observerId++
+
+		if initialId == observerId {
+			return fmt.Errorf("full queue of observers - can't allocate another")
+		}
+	}
+
+	observer.id = observerId
+	observers[observerId] = observer
+	return nil
+}
+
+func observerLookup(id uint32) *Observer {
+	observerMutex.Lock()
+	defer observerMutex.Unlock()
+
+	return observers[id]
+}
+
+func observerUnregister(id uint32) {
+	observerMutex.Lock()
+	defer observerMutex.Unlock()
+
+	delete(observers, id)
+}
+
+// closeObservers is called when the store is closing - native observers must not outlive it
+func (ob *ObjectBox) closeObservers() {
+	ob.observersMutex.Lock()
+	var toClose = make([]*Observer, 0, len(ob.observers))
+	for _, observer := range ob.observers {
+		toClose = append(toClose, observer)
+	}
+	ob.observersMutex.Unlock()
+
+	for _, observer := range toClose {
+		observer.Close()
+	}
+}
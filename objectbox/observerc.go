@@ -0,0 +1,47 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+// This file implements externs defined in observer.go.
+// It needs to be separate or it would cause duplicate symbol errors during linking.
+// See https://golang.org/cmd/cgo/#hdr-C_references_to_Go for more details.
+
+/*
+#include <stdint.h>
+*/
+import "C"
+import (
+	"unsafe"
+)
+
+// This function finds the observer (based on the pointer to the observerId) and forwards the changed type IDs to it
+// NOTE: don't change typeIds contents, it's `const obx_schema_id*` in C but go doesn't support const pointers
+//
+//export observerDispatch
+func observerDispatch(observerIdPtr unsafe.Pointer, typeIds unsafe.Pointer, typeIdsCount C.int) {
+	var observer = observerLookup(*(*uint32)(observerIdPtr))
+	if observer == nil {
+		return // already closed
+	}
+
+	var entityIds = make([]TypeId, int(typeIdsCount))
+	for i := range entityIds {
+		entityIds[i] = TypeId(*(*uint32)(unsafe.Pointer(uintptr(typeIds) + uintptr(i)*unsafe.Sizeof(uint32(0)))))
+	}
+
+	observer.notify(entityIds)
+}
@@ -231,6 +231,75 @@ func (property PropertyStringVector) Contains(text string, caseSensitive bool) C
 	}
 }
 
+// ContainsAny finds entities with the stored property value containing at least one of the given texts.
+// An empty list of texts matches no entities.
+func (property PropertyStringVector) ContainsAny(texts []string, caseSensitive bool) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringVectorContainsAny(property.BaseProperty, texts, caseSensitive)
+		},
+	}
+}
+
+// ContainsAll finds entities with the stored property value containing all of the given texts.
+// An empty list of texts matches all entities.
+func (property PropertyStringVector) ContainsAll(texts []string, caseSensitive bool) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringVectorContainsAll(property.BaseProperty, texts, caseSensitive)
+		},
+	}
+}
+
+// ContainsPrefix finds entities with the stored property value containing at least one text starting with the prefix.
+// Note: as the database doesn't support this natively, the condition is evaluated in Go on each object matching the
+// other conditions, so it can't be used inside Any() or in a link and isn't supported by property queries.
+// Combine it with native conditions to narrow the objects down, e.g. ContainsAny() with a list of known values.
+func (property PropertyStringVector) ContainsPrefix(prefix string, caseSensitive bool) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringVectorContainsPrefix(property.BaseProperty, prefix, caseSensitive)
+		},
+	}
+}
+
+// IsEmpty finds entities with no texts stored in the property, including nil.
+// Note: vector length conditions are evaluated in Go, see ContainsPrefix() for details.
+func (property PropertyStringVector) IsEmpty() Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthEquals(property.BaseProperty, 0)
+		},
+	}
+}
+
+// LengthEquals finds entities with the given number of texts stored in the property (nil counts as empty)
+func (property PropertyStringVector) LengthEquals(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthEquals(property.BaseProperty, length)
+		},
+	}
+}
+
+// LengthGreaterThan finds entities with more than the given number of texts stored in the property
+func (property PropertyStringVector) LengthGreaterThan(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthGreater(property.BaseProperty, length)
+		},
+	}
+}
+
+// LengthLessThan finds entities with fewer than the given number of texts stored in the property (nil counts as empty)
+func (property PropertyStringVector) LengthLessThan(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthLess(property.BaseProperty, length)
+		},
+	}
+}
+
 // PropertyInt64 holds information about a property and provides query building methods
 type PropertyInt64 struct {
 	*BaseProperty
@@ -1401,6 +1470,52 @@ func (property PropertyByteVector) LessOrEqual(value []byte) Condition {
 	}
 }
 
+// HasPrefix finds entities with the stored property value starting with the given bytes
+func (property PropertyByteVector) HasPrefix(prefix []byte) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.BytesHasPrefix(property.BaseProperty, prefix)
+		},
+	}
+}
+
+// IsEmpty finds entities with no bytes stored in the property, including nil
+func (property PropertyByteVector) IsEmpty() Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.BytesIsEmpty(property.BaseProperty)
+		},
+	}
+}
+
+// LengthEquals finds entities with the given number of bytes stored in the property (nil counts as empty).
+// Note: vector length conditions are evaluated in Go, see PropertyStringVector.ContainsPrefix() for details.
+func (property PropertyByteVector) LengthEquals(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthEquals(property.BaseProperty, length)
+		},
+	}
+}
+
+// LengthGreaterThan finds entities with more than the given number of bytes stored in the property
+func (property PropertyByteVector) LengthGreaterThan(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthGreater(property.BaseProperty, length)
+		},
+	}
+}
+
+// LengthLessThan finds entities with fewer than the given number of bytes stored in the property (nil counts as empty)
+func (property PropertyByteVector) LengthLessThan(length int) Condition {
+	return &conditionClosure{
+		apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.VectorLengthLess(property.BaseProperty, length)
+		},
+	}
+}
+
 // PropertyBool holds information about a property and provides query building methods
 type PropertyBool struct {
 	*BaseProperty
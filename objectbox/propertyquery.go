@@ -0,0 +1,430 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+)
+
+// PropertyQuery provides access to values of a single property across all objects matching a query,
+// e.g. to calculate aggregates without loading whole objects. Create it using Query.Property().
+//
+// For example, you can compute the sum of all priorities of unfinished tasks:
+//
+//	box.Query(Task_.DateFinished.Equals(0)).Property(Task_.Priority).Sum()
+//
+// To get methods matching the property type checked at compile time, use the property's Query() method instead:
+//
+//	Task_.Priority.Query(query).Max() // returns (int32, error) for an int32 property
+//
+// Note: property queries don't support query offset & limit.
+type PropertyQuery struct {
+	query    *Query
+	property propertyOrAlias
+	distinct bool
+}
+
+// Property creates a property query on the given property of the queried entity
+func (query *Query) Property(property propertyOrAlias) *PropertyQuery {
+	return &PropertyQuery{query: query, property: property}
+}
+
+// Distinct configures the Find* methods for numeric properties to return only distinct values.
+// Count() and FindStrings() take their own "distinct" arguments instead.
+func (pq *PropertyQuery) Distinct(distinct bool) *PropertyQuery {
+	pq.distinct = distinct
+	return pq
+}
+
+// run executes fn with a native property query created on the current state of the query
+func (pq *PropertyQuery) run(fn func(cPropQuery *C.OBX_query_prop) error) error {
+	var query = pq.query
+	defer runtime.KeepAlive(query)
+
+	if pq.property.alias() != nil {
+		return fmt.Errorf("property queries don't support aliases, use a property instead")
+	}
+
+	if pq.property.entityId() != query.entity.id {
+		return fmt.Errorf("property from a different entity %d passed, expected %d", pq.property.entityId(), query.entity.id)
+	}
+
+	// doesn't support offset/limit at this point
+	if query.offset != 0 || query.limit != 0 {
+		return fmt.Errorf("limit/offset are not supported by property queries at this moment")
+	}
+
+	// the native property query would ignore the objects excluded in Go
+	if query.isFiltered() {
+		return fmt.Errorf("property queries don't support Filter() and conditions evaluated in Go, e.g. vector length")
+	}
+
+	if query.cQuery == nil {
+		return query.errorClosed()
+	}
+
+	if err := query.box.checkTx(); err != nil {
+		return err
+	}
+
+	// for native calls/createError()
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	var cPropQuery = C.obx_query_prop(query.cQuery, C.obx_schema_id(pq.property.propertyId()))
+	if cPropQuery == nil {
+		return createError()
+	}
+	defer C.obx_query_prop_close(cPropQuery)
+
+	return fn(cPropQuery)
+}
+
+// runNumeric is like run but configures the numeric distinct flag first
+func (pq *PropertyQuery) runNumeric(fn func(cPropQuery *C.OBX_query_prop) error) error {
+	return pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if pq.distinct {
+			if rc := C.obx_query_prop_distinct(cPropQuery, C.bool(true)); rc != 0 {
+				return createError()
+			}
+		}
+		return fn(cPropQuery)
+	})
+}
+
+// Count returns the number of non-nil values of the property across all objects matching the query.
+// If distinct is true, each value is counted only once (case sensitive for strings).
+func (pq *PropertyQuery) Count(distinct bool) (uint64, error) {
+	var cResult C.uint64_t
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if distinct {
+			if rc := C.obx_query_prop_distinct(cPropQuery, C.bool(true)); rc != 0 {
+				return createError()
+			}
+		}
+		if rc := C.obx_query_prop_count(cPropQuery, &cResult); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return uint64(cResult), err
+}
+
+// Sum calculates the sum of an integer property across all objects matching the query.
+// Fails if the result doesn't fit into an int64; use SumFloat64() for floating point properties.
+func (pq *PropertyQuery) Sum() (int64, error) {
+	var cResult C.int64_t
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_sum_int(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return int64(cResult), err
+}
+
+// SumFloat64 calculates the sum of a floating point property across all objects matching the query
+func (pq *PropertyQuery) SumFloat64() (float64, error) {
+	var cResult C.double
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_sum(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return float64(cResult), err
+}
+
+// Min finds the minimum value of an integer property across all objects matching the query.
+// Use MinFloat64() for floating point properties.
+func (pq *PropertyQuery) Min() (int64, error) {
+	var cResult C.int64_t
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_min_int(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return int64(cResult), err
+}
+
+// MinFloat64 finds the minimum value of a floating point property across all objects matching the query
+func (pq *PropertyQuery) MinFloat64() (float64, error) {
+	var cResult C.double
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_min(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return float64(cResult), err
+}
+
+// Max finds the maximum value of an integer property across all objects matching the query.
+// Use MaxFloat64() for floating point properties.
+func (pq *PropertyQuery) Max() (int64, error) {
+	var cResult C.int64_t
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_max_int(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return int64(cResult), err
+}
+
+// MaxFloat64 finds the maximum value of a floating point property across all objects matching the query
+func (pq *PropertyQuery) MaxFloat64() (float64, error) {
+	var cResult C.double
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_max(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return float64(cResult), err
+}
+
+// Average calculates the average value of a numeric property across all objects matching the query
+func (pq *PropertyQuery) Average() (float64, error) {
+	var cResult C.double
+	var err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if rc := C.obx_query_prop_avg(cPropQuery, &cResult, nil); rc != 0 {
+			return createError()
+		}
+		return nil
+	})
+	return float64(cResult), err
+}
+
+// FindStrings returns values of a string property across all objects matching the query, skipping nil values.
+// If distinct is true, each value is returned only once, compared according to caseSensitive.
+func (pq *PropertyQuery) FindStrings(distinct, caseSensitive bool) (result []string, err error) {
+	err = pq.run(func(cPropQuery *C.OBX_query_prop) error {
+		if distinct {
+			if rc := C.obx_query_prop_distinct_case(cPropQuery, C.bool(true), C.bool(caseSensitive)); rc != 0 {
+				return createError()
+			}
+		}
+
+		var cArray = C.obx_query_prop_string_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_string_array_free(cArray)
+
+		result = cStringArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+func (pq *PropertyQuery) findInt64s() (result []int64, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_int64_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_int64_array_free(cArray)
+
+		result = cInt64ArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+func (pq *PropertyQuery) findInt32s() (result []int32, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_int32_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_int32_array_free(cArray)
+
+		result = cInt32ArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+func (pq *PropertyQuery) findInt16s() (result []int16, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_int16_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_int16_array_free(cArray)
+
+		result = cInt16ArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+func (pq *PropertyQuery) findInt8s() (result []int8, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_int8_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_int8_array_free(cArray)
+
+		result = cInt8ArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+// FindInt64s returns values of an int64 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindInt64s() ([]int64, error) {
+	return pq.findInt64s()
+}
+
+// FindUint64s returns values of a uint64 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindUint64s() ([]uint64, error) {
+	values, err := pq.findInt64s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]uint64, len(values))
+	for i, v := range values {
+		result[i] = uint64(v)
+	}
+	return result, nil
+}
+
+// FindInts returns values of an int property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindInts() ([]int, error) {
+	values, err := pq.findInt64s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result, nil
+}
+
+// FindUints returns values of a uint property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindUints() ([]uint, error) {
+	values, err := pq.findInt64s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]uint, len(values))
+	for i, v := range values {
+		result[i] = uint(v)
+	}
+	return result, nil
+}
+
+// FindInt32s returns values of an int32 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindInt32s() ([]int32, error) {
+	return pq.findInt32s()
+}
+
+// FindUint32s returns values of a uint32 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindUint32s() ([]uint32, error) {
+	values, err := pq.findInt32s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]uint32, len(values))
+	for i, v := range values {
+		result[i] = uint32(v)
+	}
+	return result, nil
+}
+
+// FindRunes returns values of a rune property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindRunes() ([]rune, error) {
+	return pq.findInt32s()
+}
+
+// FindInt16s returns values of an int16 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindInt16s() ([]int16, error) {
+	return pq.findInt16s()
+}
+
+// FindUint16s returns values of a uint16 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindUint16s() ([]uint16, error) {
+	values, err := pq.findInt16s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]uint16, len(values))
+	for i, v := range values {
+		result[i] = uint16(v)
+	}
+	return result, nil
+}
+
+// FindInt8s returns values of an int8 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindInt8s() ([]int8, error) {
+	return pq.findInt8s()
+}
+
+// FindUint8s returns values of a uint8 (byte) property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindUint8s() ([]uint8, error) {
+	values, err := pq.findInt8s()
+	if err != nil {
+		return nil, err
+	}
+	var result = make([]uint8, len(values))
+	for i, v := range values {
+		result[i] = uint8(v)
+	}
+	return result, nil
+}
+
+// FindFloat64s returns values of a float64 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindFloat64s() (result []float64, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_double_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_double_array_free(cArray)
+
+		result = cDoubleArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
+// FindFloat32s returns values of a float32 property across all objects matching the query, skipping nil values
+func (pq *PropertyQuery) FindFloat32s() (result []float32, err error) {
+	err = pq.runNumeric(func(cPropQuery *C.OBX_query_prop) error {
+		var cArray = C.obx_query_prop_float_find(cPropQuery, nil)
+		if cArray == nil {
+			return createError()
+		}
+		defer C.obx_float_array_free(cArray)
+
+		result = cFloatArrayToGo(cArray)
+		return nil
+	})
+	return result, err
+}
+
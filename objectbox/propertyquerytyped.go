@@ -0,0 +1,512 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+// integerPropertyQuery provides the aggregates common to all integer property queries
+type integerPropertyQuery struct {
+	pq *PropertyQuery
+}
+
+// Count returns the number of non-nil values of the property across all objects matching the query.
+// If distinct is true, each value is counted only once.
+func (q integerPropertyQuery) Count(distinct bool) (uint64, error) {
+	return q.pq.Count(distinct)
+}
+
+// Sum calculates the sum of the property across all objects matching the query.
+// Fails if the result doesn't fit into an int64.
+func (q integerPropertyQuery) Sum() (int64, error) {
+	return q.pq.Sum()
+}
+
+// Average calculates the average value of the property across all objects matching the query
+func (q integerPropertyQuery) Average() (float64, error) {
+	return q.pq.Average()
+}
+
+// floatPropertyQuery provides the aggregates common to all floating point property queries
+type floatPropertyQuery struct {
+	pq *PropertyQuery
+}
+
+// Count returns the number of non-nil values of the property across all objects matching the query.
+// If distinct is true, each value is counted only once.
+func (q floatPropertyQuery) Count(distinct bool) (uint64, error) {
+	return q.pq.Count(distinct)
+}
+
+// Sum calculates the sum of the property across all objects matching the query
+func (q floatPropertyQuery) Sum() (float64, error) {
+	return q.pq.SumFloat64()
+}
+
+// Average calculates the average value of the property across all objects matching the query
+func (q floatPropertyQuery) Average() (float64, error) {
+	return q.pq.Average()
+}
+
+// StringPropertyQuery is a property query on a string property, see PropertyString.Query()
+type StringPropertyQuery struct {
+	pq *PropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyString) Query(query *Query) *StringPropertyQuery {
+	return &StringPropertyQuery{query.Property(property)}
+}
+
+// Count returns the number of non-nil values of the property across all objects matching the query.
+// If distinct is true, each value is counted only once (case sensitive).
+func (q *StringPropertyQuery) Count(distinct bool) (uint64, error) {
+	return q.pq.Count(distinct)
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values.
+// If distinct is true, each value is returned only once, compared according to caseSensitive.
+func (q *StringPropertyQuery) Find(distinct, caseSensitive bool) ([]string, error) {
+	return q.pq.FindStrings(distinct, caseSensitive)
+}
+
+// Int64PropertyQuery is a property query on an int64 property, see PropertyInt64.Query()
+type Int64PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyInt64) Query(query *Query) *Int64PropertyQuery {
+	return &Int64PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Int64PropertyQuery) Distinct(distinct bool) *Int64PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Int64PropertyQuery) Min() (int64, error) {
+	return q.pq.Min()
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Int64PropertyQuery) Max() (int64, error) {
+	return q.pq.Max()
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Int64PropertyQuery) Find() ([]int64, error) {
+	return q.pq.FindInt64s()
+}
+
+// IntPropertyQuery is a property query on an int property, see PropertyInt.Query()
+type IntPropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyInt) Query(query *Query) *IntPropertyQuery {
+	return &IntPropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *IntPropertyQuery) Distinct(distinct bool) *IntPropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *IntPropertyQuery) Min() (int, error) {
+	value, err := q.pq.Min()
+	return int(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *IntPropertyQuery) Max() (int, error) {
+	value, err := q.pq.Max()
+	return int(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *IntPropertyQuery) Find() ([]int, error) {
+	return q.pq.FindInts()
+}
+
+// Uint64PropertyQuery is a property query on a uint64 property, see PropertyUint64.Query()
+type Uint64PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyUint64) Query(query *Query) *Uint64PropertyQuery {
+	return &Uint64PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Uint64PropertyQuery) Distinct(distinct bool) *Uint64PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Uint64PropertyQuery) Min() (uint64, error) {
+	value, err := q.pq.Min()
+	return uint64(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Uint64PropertyQuery) Max() (uint64, error) {
+	value, err := q.pq.Max()
+	return uint64(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Uint64PropertyQuery) Find() ([]uint64, error) {
+	return q.pq.FindUint64s()
+}
+
+// UintPropertyQuery is a property query on a uint property, see PropertyUint.Query()
+type UintPropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyUint) Query(query *Query) *UintPropertyQuery {
+	return &UintPropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *UintPropertyQuery) Distinct(distinct bool) *UintPropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *UintPropertyQuery) Min() (uint, error) {
+	value, err := q.pq.Min()
+	return uint(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *UintPropertyQuery) Max() (uint, error) {
+	value, err := q.pq.Max()
+	return uint(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *UintPropertyQuery) Find() ([]uint, error) {
+	return q.pq.FindUints()
+}
+
+// RunePropertyQuery is a property query on a rune property, see PropertyRune.Query()
+type RunePropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyRune) Query(query *Query) *RunePropertyQuery {
+	return &RunePropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *RunePropertyQuery) Distinct(distinct bool) *RunePropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *RunePropertyQuery) Min() (rune, error) {
+	value, err := q.pq.Min()
+	return rune(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *RunePropertyQuery) Max() (rune, error) {
+	value, err := q.pq.Max()
+	return rune(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *RunePropertyQuery) Find() ([]rune, error) {
+	return q.pq.FindRunes()
+}
+
+// Int32PropertyQuery is a property query on an int32 property, see PropertyInt32.Query()
+type Int32PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyInt32) Query(query *Query) *Int32PropertyQuery {
+	return &Int32PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Int32PropertyQuery) Distinct(distinct bool) *Int32PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Int32PropertyQuery) Min() (int32, error) {
+	value, err := q.pq.Min()
+	return int32(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Int32PropertyQuery) Max() (int32, error) {
+	value, err := q.pq.Max()
+	return int32(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Int32PropertyQuery) Find() ([]int32, error) {
+	return q.pq.FindInt32s()
+}
+
+// Uint32PropertyQuery is a property query on a uint32 property, see PropertyUint32.Query()
+type Uint32PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyUint32) Query(query *Query) *Uint32PropertyQuery {
+	return &Uint32PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Uint32PropertyQuery) Distinct(distinct bool) *Uint32PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Uint32PropertyQuery) Min() (uint32, error) {
+	value, err := q.pq.Min()
+	return uint32(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Uint32PropertyQuery) Max() (uint32, error) {
+	value, err := q.pq.Max()
+	return uint32(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Uint32PropertyQuery) Find() ([]uint32, error) {
+	return q.pq.FindUint32s()
+}
+
+// Int16PropertyQuery is a property query on an int16 property, see PropertyInt16.Query()
+type Int16PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyInt16) Query(query *Query) *Int16PropertyQuery {
+	return &Int16PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Int16PropertyQuery) Distinct(distinct bool) *Int16PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Int16PropertyQuery) Min() (int16, error) {
+	value, err := q.pq.Min()
+	return int16(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Int16PropertyQuery) Max() (int16, error) {
+	value, err := q.pq.Max()
+	return int16(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Int16PropertyQuery) Find() ([]int16, error) {
+	return q.pq.FindInt16s()
+}
+
+// Uint16PropertyQuery is a property query on a uint16 property, see PropertyUint16.Query()
+type Uint16PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyUint16) Query(query *Query) *Uint16PropertyQuery {
+	return &Uint16PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Uint16PropertyQuery) Distinct(distinct bool) *Uint16PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Uint16PropertyQuery) Min() (uint16, error) {
+	value, err := q.pq.Min()
+	return uint16(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Uint16PropertyQuery) Max() (uint16, error) {
+	value, err := q.pq.Max()
+	return uint16(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Uint16PropertyQuery) Find() ([]uint16, error) {
+	return q.pq.FindUint16s()
+}
+
+// Int8PropertyQuery is a property query on an int8 property, see PropertyInt8.Query()
+type Int8PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyInt8) Query(query *Query) *Int8PropertyQuery {
+	return &Int8PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Int8PropertyQuery) Distinct(distinct bool) *Int8PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Int8PropertyQuery) Min() (int8, error) {
+	value, err := q.pq.Min()
+	return int8(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Int8PropertyQuery) Max() (int8, error) {
+	value, err := q.pq.Max()
+	return int8(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Int8PropertyQuery) Find() ([]int8, error) {
+	return q.pq.FindInt8s()
+}
+
+// Uint8PropertyQuery is a property query on a uint8 property, see PropertyUint8.Query()
+type Uint8PropertyQuery struct {
+	integerPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyUint8) Query(query *Query) *Uint8PropertyQuery {
+	return &Uint8PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Uint8PropertyQuery) Distinct(distinct bool) *Uint8PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Uint8PropertyQuery) Min() (uint8, error) {
+	value, err := q.pq.Min()
+	return uint8(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Uint8PropertyQuery) Max() (uint8, error) {
+	value, err := q.pq.Max()
+	return uint8(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Uint8PropertyQuery) Find() ([]uint8, error) {
+	return q.pq.FindUint8s()
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyByte) Query(query *Query) *Uint8PropertyQuery {
+	return &Uint8PropertyQuery{integerPropertyQuery{query.Property(property)}}
+}
+
+// Float64PropertyQuery is a property query on a float64 property, see PropertyFloat64.Query()
+type Float64PropertyQuery struct {
+	floatPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyFloat64) Query(query *Query) *Float64PropertyQuery {
+	return &Float64PropertyQuery{floatPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Float64PropertyQuery) Distinct(distinct bool) *Float64PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Float64PropertyQuery) Min() (float64, error) {
+	return q.pq.MinFloat64()
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Float64PropertyQuery) Max() (float64, error) {
+	return q.pq.MaxFloat64()
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Float64PropertyQuery) Find() ([]float64, error) {
+	return q.pq.FindFloat64s()
+}
+
+// Float32PropertyQuery is a property query on a float32 property, see PropertyFloat32.Query()
+type Float32PropertyQuery struct {
+	floatPropertyQuery
+}
+
+// Query creates a property query on this property with methods matching its type, see Query.Property()
+func (property PropertyFloat32) Query(query *Query) *Float32PropertyQuery {
+	return &Float32PropertyQuery{floatPropertyQuery{query.Property(property)}}
+}
+
+// Distinct configures Find() to return only distinct values
+func (q *Float32PropertyQuery) Distinct(distinct bool) *Float32PropertyQuery {
+	q.pq.Distinct(distinct)
+	return q
+}
+
+// Min finds the minimum value of the property across all objects matching the query
+func (q *Float32PropertyQuery) Min() (float32, error) {
+	value, err := q.pq.MinFloat64()
+	return float32(value), err
+}
+
+// Max finds the maximum value of the property across all objects matching the query
+func (q *Float32PropertyQuery) Max() (float32, error) {
+	value, err := q.pq.MaxFloat64()
+	return float32(value), err
+}
+
+// Find returns values of the property across all objects matching the query, skipping nil values
+func (q *Float32PropertyQuery) Find() ([]float32, error) {
+	return q.pq.FindFloat32s()
+}
@@ -22,6 +22,7 @@ package objectbox
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
 	"runtime"
@@ -43,6 +44,29 @@ type Query struct {
 	offset          uint64
 	limit           uint64
 	linkedEntityIds []TypeId
+	plan            *QueryPlan
+
+	// the conditions and the order (by precedence) the query was built with, used by Page()
+	conditions []Condition
+	order      []queryOrder
+
+	// Go-side predicate applied to the objects matching the native conditions, see Filter()
+	filter func(object interface{}) bool
+
+	// conditions created by negating a condition into multiple ones, their parameters can't be changed by property
+	expandedConditions []QueryPlanCondition
+
+	// Go-side conditions applied to the FlatBuffers data of the objects matching the native ones, before loading
+	matchers []func(bytes []byte) bool
+
+	// the last parameter change on each property/alias, re-applied when the query is rebuilt, see rememberParams()
+	params map[string]func(query *Query) error
+}
+
+// queryOrder describes a single order condition of a query, i.e. the property and its OBXOrderFlags
+type queryOrder struct {
+	propertyId TypeId
+	flags      C.OBXOrderFlags
 }
 
 // Close frees (native) resources held by this Query.
@@ -61,6 +85,48 @@ func (query *Query) Close() error {
 	return nil
 }
 
+// Clone creates an independent copy of the query, including the current parameters, offset and limit.
+// The clone can be used (and its parameters changed) concurrently with the original query, e.g. on another goroutine.
+// See QueryPool if you need to reuse a prepared query across many goroutines.
+func (query *Query) Clone() (*Query, error) {
+	query.closeMutex.Lock()
+	defer query.closeMutex.Unlock()
+
+	if query.cQuery == nil {
+		return nil, query.errorClosed()
+	}
+
+	var clone = &Query{
+		entity:             query.entity,
+		objectBox:          query.objectBox,
+		box:                query.box,
+		offset:             query.offset,
+		limit:              query.limit,
+		linkedEntityIds:    query.linkedEntityIds,
+		plan:               query.plan,
+		conditions:         query.conditions,
+		order:              query.order,
+		filter:             query.filter,
+		matchers:           query.matchers,
+		expandedConditions: query.expandedConditions,
+		params:             make(map[string]func(query *Query) error, len(query.params)),
+	}
+
+	for key, set := range query.params {
+		clone.params[key] = set
+	}
+
+	if err := cCallBool(func() bool {
+		clone.cQuery = C.obx_query_clone(query.cQuery)
+		return clone.cQuery != nil
+	}); err != nil {
+		return nil, err
+	}
+
+	clone.installFinalizer()
+	return clone, nil
+}
+
 func queryFinalizer(query *Query) {
 	err := query.Close()
 	if err != nil {
@@ -76,7 +142,7 @@ func (query *Query) installFinalizer() {
 }
 
 func (query *Query) errorClosed() error {
-	return errors.New("illegal state; query was closed")
+	return newIllegalStateError("illegal state; query was closed")
 }
 
 // Find returns all objects matching the query
@@ -87,6 +153,10 @@ func (query *Query) Find() (objects interface{}, err error) {
 		return 0, query.errorClosed()
 	}
 
+	if query.isFiltered() {
+		return query.findFiltered(context.Background())
+	}
+
 	const existingOnly = true
 	if supportsBytesArray {
 		var cFn = func() *C.OBX_bytes_array {
@@ -102,6 +172,202 @@ func (query *Query) Find() (objects interface{}, err error) {
 	return query.box.readUsingVisitor(existingOnly, cFn)
 }
 
+// FindContext is like Find but stops reading the results when the context is done, returning ctx.Err().
+func (query *Query) FindContext(ctx context.Context) (objects interface{}, err error) {
+	defer runtime.KeepAlive(query)
+
+	if query.cQuery == nil {
+		return nil, query.errorClosed()
+	}
+
+	if query.isFiltered() {
+		return query.findFiltered(ctx)
+	}
+
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg,
+			C.uint64_t(query.offset), C.uint64_t(query.limit))
+	}
+	return query.box.readUsingVisitorContext(ctx, cFn)
+}
+
+// findFiltered collects the objects accepted by the query filter, checking the context before each object
+func (query *Query) findFiltered(ctx context.Context) (objects interface{}, err error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var binding = query.entity.binding
+	var slice = binding.MakeSlice(defaultSliceCapacity)
+	err = query.forEach(func(object interface{}) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		slice = binding.AppendToSlice(slice, object)
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return slice, nil
+}
+
+// Filter sets a function deciding whether an object matching the (native) query conditions is part of the result.
+// Use it for conditions which can't be expressed using the query builder, e.g. regular expressions, custom business
+// rules or properties with a converter, like time.Time. The function is called for each object as it's read during
+// the query execution, before it's collected, so objects not accepted are never part of the result slice.
+// Offset and Limit are applied to the filtered objects. Set nil to remove the filter.
+//
+// The filter is respected by all methods returning or processing objects, i.e. Find*, ForEach, Stream, Count*,
+// Remove, Page and Subscribe. Note: filtered queries need to read all the objects matching the native conditions,
+// so use native conditions to narrow the objects down as far as possible.
+func (query *Query) Filter(fn func(object interface{}) bool) *Query {
+	query.filter = fn
+	return query
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *Query) FindFirst() (object interface{}, err error) {
+	err = query.visitMax(1, func(o interface{}) {
+		object = o
+	})
+	return object, err
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns ErrNonUniqueResult if more than one object matches (considering the offset and limit, if set).
+func (query *Query) FindUnique() (object interface{}, err error) {
+	var count = 0
+	err = query.visitMax(2, func(o interface{}) {
+		object = o
+		count++
+	})
+	if err == nil && count > 1 {
+		return nil, ErrNonUniqueResult
+	}
+	return object, err
+}
+
+// visitMax reads at most max objects (fewer if the query limit is lower) and passes them to fn
+func (query *Query) visitMax(max uint64, fn func(object interface{})) error {
+	var limit = max
+	if query.limit != 0 && query.limit < limit {
+		limit = query.limit
+	}
+
+	return query.visit(query.offset, limit, func(object interface{}) (bool, error) {
+		fn(object)
+		return true, nil
+	})
+}
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+// Offset and Limit are respected. The objects are read in a single read transaction, which is kept open until
+// ForEach returns, so fn should not run for too long.
+func (query *Query) ForEach(fn func(object interface{}) bool) error {
+	return query.forEach(func(object interface{}) (bool, error) {
+		return fn(object), nil
+	})
+}
+
+// Result is a single item sent by Query.Stream(); either an Object or an Err is set.
+type Result struct {
+	Object interface{}
+	Err    error
+}
+
+// Stream reads objects matching the query on a separate goroutine and sends them to the returned channel one by one.
+// Offset and Limit are respected. The channel is closed after the last object has been sent or after an error,
+// which is sent as the last Result. Stop reading early by cancelling the context; the channel is closed then as well.
+//
+// Note: the read transaction stays open until the channel is closed, so either read all the results or cancel ctx.
+func (query *Query) Stream(ctx context.Context) <-chan Result {
+	var results = make(chan Result)
+
+	go func() {
+		defer close(results)
+
+		var err = query.forEach(func(object interface{}) (bool, error) {
+			select {
+			case results <- Result{Object: object}:
+				return true, nil
+			case <-ctx.Done():
+				return false, nil
+			}
+		})
+
+		if err != nil {
+			select {
+			case results <- Result{Err: err}:
+			case <-ctx.Done():
+			}
+		}
+	}()
+
+	return results
+}
+
+// forEach streams the query results to fn one by one, respecting the query offset and limit
+func (query *Query) forEach(fn func(object interface{}) (bool, error)) error {
+	return query.visit(query.offset, query.limit, fn)
+}
+
+// visit streams the objects to fn one by one, applying the given offset and limit.
+// If the query has a filter, only the accepted objects are passed to fn and the offset and limit apply to those.
+func (query *Query) visit(offset, limit uint64, fn func(object interface{}) (bool, error)) error {
+	defer runtime.KeepAlive(query)
+
+	if query.cQuery == nil {
+		return query.errorClosed()
+	}
+
+	if query.isFiltered() {
+		fn = filterObjects(query.filter, offset, limit, fn)
+		offset, limit = 0, 0 // applied by filterObjects()
+	}
+
+	var cFn = func(visitorArg unsafe.Pointer) C.obx_err {
+		return C.obx_query_visit(query.cQuery, dataVisitor, visitorArg, C.uint64_t(offset), C.uint64_t(limit))
+	}
+	return query.box.visitObjects(cFn, query.match, fn)
+}
+
+// isFiltered returns true if some objects matching the native conditions may be excluded from the results in Go,
+// i.e. by a filter (see Filter()) or conditions the core doesn't support natively, e.g. on string vector elements
+func (query *Query) isFiltered() bool {
+	return query.filter != nil || len(query.matchers) > 0
+}
+
+// match checks the FlatBuffers data of an object against the Go-side conditions
+func (query *Query) match(bytes []byte) bool {
+	for _, matcher := range query.matchers {
+		if !matcher(bytes) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterObjects wraps fn to only receive objects accepted by the filter (if not nil), skipping the first offset
+// accepted objects and stopping the visit after limit objects (if not zero) have been passed to fn
+func filterObjects(filter func(object interface{}) bool, offset, limit uint64, fn func(object interface{}) (bool, error)) func(object interface{}) (bool, error) {
+	var skipped, passed uint64
+	return func(object interface{}) (bool, error) {
+		if filter != nil && !filter(object) {
+			return true, nil
+		} else if skipped < offset {
+			skipped++
+			return true, nil
+		}
+
+		passed++
+		next, err := fn(object)
+		return next && (limit == 0 || passed < limit), err
+	}
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *Query) Offset(offset uint64) *Query {
 	query.offset = offset
@@ -122,28 +388,102 @@ func (query *Query) FindIds() ([]uint64, error) {
 		return nil, query.errorClosed()
 	}
 
+	if err := query.box.checkTx(); err != nil {
+		return nil, err
+	}
+
+	if query.isFiltered() {
+		var ids []uint64
+		var err = query.forEach(func(object interface{}) (bool, error) {
+			id, err := query.entity.binding.GetId(object)
+			ids = append(ids, id)
+			return true, err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return ids, nil
+	}
+
 	return cGetIds(func() *C.OBX_id_array {
 		return C.obx_query_find_ids(query.cQuery, C.uint64_t(query.offset), C.uint64_t(query.limit))
 	})
 }
 
-// Count returns the number of objects matching the query
+// Count returns the number of objects matching the query, respecting the offset and limit if set
 func (query *Query) Count() (uint64, error) {
-	// doesn't support offset/limit at this point
-	if query.offset != 0 || query.limit != 0 {
-		return 0, fmt.Errorf("limit/offset are not supported by Count at this moment")
-	}
-
 	if query.cQuery == nil {
 		return 0, query.errorClosed()
 	}
 
+	if err := query.box.checkTx(); err != nil {
+		return 0, err
+	}
+
+	if query.isFiltered() {
+		return query.countFiltered(query.limit)
+	}
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_count(query.cQuery, &cResult) }); err != nil {
 		return 0, err
 	}
 	runtime.KeepAlive(query)
-	return uint64(cResult), nil
+
+	var count = uint64(cResult)
+	if count <= query.offset {
+		return 0, nil
+	}
+	count = count - query.offset
+
+	if query.limit != 0 && count > query.limit {
+		count = query.limit
+	}
+	return count, nil
+}
+
+// CountMax returns the number of objects matching the query (respecting the offset and limit if set),
+// up to the given maximum. As opposed to Count(), the matching stops as soon as the maximum is reached.
+// Use it to cheaply check e.g. if there are "more than 1000 results". If max is 0, this is equivalent to Count().
+func (query *Query) CountMax(max uint64) (uint64, error) {
+	if max == 0 {
+		return query.Count()
+	}
+
+	if query.cQuery == nil {
+		return 0, query.errorClosed()
+	}
+
+	if err := query.box.checkTx(); err != nil {
+		return 0, err
+	}
+
+	var limit = max
+	if query.limit != 0 && query.limit < limit {
+		limit = query.limit
+	}
+
+	if query.isFiltered() {
+		return query.countFiltered(limit)
+	}
+
+	ids, err := cGetIds(func() *C.OBX_id_array {
+		return C.obx_query_find_ids(query.cQuery, C.uint64_t(query.offset), C.uint64_t(limit))
+	})
+	runtime.KeepAlive(query)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(ids)), nil
+}
+
+// countFiltered counts the objects accepted by the query filter, up to the given limit (if not zero)
+func (query *Query) countFiltered(limit uint64) (count uint64, err error) {
+	err = query.visit(query.offset, limit, func(object interface{}) (bool, error) {
+		count++
+		return true, nil
+	})
+	return count, err
 }
 
 // Remove permanently deletes all objects matching the query from the database
@@ -157,6 +497,26 @@ func (query *Query) Remove() (count uint64, err error) {
 		return 0, query.errorClosed()
 	}
 
+	if err := query.box.checkTx(); err != nil {
+		return 0, err
+	}
+
+	if query.isFiltered() {
+		// find and remove the accepted objects in a single transaction so that the result is consistent
+		err = query.objectBox.RunInWriteTx(func() error {
+			ids, err := query.FindIds()
+			if err != nil {
+				return err
+			}
+			count, err = query.box.RemoveIds(ids...)
+			return err
+		})
+		if err != nil {
+			count = 0
+		}
+		return count, err
+	}
+
 	var cResult C.uint64_t
 	if err := cCall(func() C.obx_err { return C.obx_query_remove(query.cQuery, &cResult) }); err != nil {
 		return 0, err
@@ -166,6 +526,42 @@ func (query *Query) Remove() (count uint64, err error) {
 	return uint64(cResult), nil
 }
 
+// RemoveContext is like Remove but runs in a write transaction which is rolled back if the context is done
+// by the time the objects are removed. In that case, nothing is removed and ctx.Err() is returned.
+func (query *Query) RemoveContext(ctx context.Context) (count uint64, err error) {
+	err = query.box.ObjectBox.RunInWriteTxContext(ctx, func() error {
+		var err2 error
+		count, err2 = query.Remove()
+		return err2
+	})
+
+	if err != nil {
+		count = 0
+	}
+	return count, err
+}
+
+// Describe returns a string representation of the whole query, including the queried entity and the conditions,
+// as provided by the native query engine. It doesn't tell how the query is executed, e.g. which indexes are used;
+// see Plan() for a (Go-side) estimate.
+func (query *Query) Describe() (string, error) {
+	if query.cQuery == nil {
+		return "", query.errorClosed()
+	}
+
+	// no need to free, it's handled by the cQuery internally
+	cResult := C.obx_query_describe(query.cQuery)
+
+	runtime.KeepAlive(query)
+	return C.GoString(cResult), nil
+}
+
+// Plan returns an estimate of whether the query conditions can be served by an index, computed in Go from the model.
+// It's not the plan executed by the native query engine, see QueryPlan for details.
+func (query *Query) Plan() *QueryPlan {
+	return query.plan
+}
+
 // DescribeParams returns a string representation of the query conditions
 func (query *Query) DescribeParams() (string, error) {
 	if query.cQuery == nil {
@@ -179,6 +575,41 @@ func (query *Query) DescribeParams() (string, error) {
 	return C.GoString(cResult), nil
 }
 
+// Subscribe calls fn with the current query result and then again after each committed transaction changing objects
+// of the queried entity or any entity linked by the query conditions. The function is called on a separate goroutine,
+// one call at a time. The query must not be changed (e.g. its parameters) while subscribed.
+// Close the returned observer to stop receiving updates.
+func (query *Query) Subscribe(fn func(objects interface{}, err error)) (*Observer, error) {
+	if fn == nil {
+		return nil, fmt.Errorf("subscription callback function must not be nil")
+	}
+
+	if query.cQuery == nil {
+		return nil, query.errorClosed()
+	}
+
+	var entityIds = append([]TypeId{query.entity.id}, query.linkedEntityIds...)
+	observer, err := query.objectBox.subscribe(entityIds, func([]TypeId) {
+		fn(query.Find())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		// deliver the current state first, unless already unsubscribed
+		select {
+		case <-observer.done:
+			return
+		default:
+			fn(query.Find())
+		}
+		observer.run()
+	}()
+
+	return observer, nil
+}
+
 func (query *Query) checkIdentifier(identifier propertyOrAlias) error {
 	// NOTE: maybe validate if the alias was previously used in this query?
 	if identifier.alias() != nil {
@@ -187,6 +618,13 @@ func (query *Query) checkIdentifier(identifier propertyOrAlias) error {
 
 	var entityId = identifier.entityId()
 
+	for _, condition := range query.expandedConditions {
+		if condition.EntityId == entityId && condition.PropertyId == identifier.propertyId() {
+			return fmt.Errorf("can't set parameters of property %s: its negated condition was replaced by multiple "+
+				"conditions, e.g. NOT(a > 5) by a < 5 OR a == 5", condition.Property)
+		}
+	}
+
 	if query.entity.id == entityId {
 		return nil
 	}
@@ -213,9 +651,52 @@ type propertyOrAlias interface {
 	alias() *string
 }
 
+// rebuild creates a new query with the given conditions, applying the parameters changed on this query
+func (query *Query) rebuild(conditions ...Condition) (*Query, error) {
+	if query.box == nil {
+		return nil, errors.New("can't rebuild a query not created by a box")
+	}
+
+	rebuilt, err := query.box.QueryOrError(conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, set := range query.params {
+		if err := set(rebuilt); err != nil {
+			rebuilt.Close()
+			return nil, err
+		}
+	}
+	return rebuilt, nil
+}
+
+// rememberParams records a successful parameter change, replacing the previous one on the same property/alias,
+// so that it can be applied again when the query is rebuilt, e.g. by SetOrderParams() or Page()
+func (query *Query) rememberParams(err *error, identifier propertyOrAlias, set func(query *Query) error) {
+	if *err != nil {
+		return
+	}
+
+	var key string
+	if alias := identifier.alias(); alias != nil {
+		key = "alias:" + *alias
+	} else {
+		key = fmt.Sprintf("property:%d:%d", identifier.entityId(), identifier.propertyId())
+	}
+
+	if query.params == nil {
+		query.params = make(map[string]func(query *Query) error)
+	}
+	query.params[key] = set
+}
+
 // SetStringParams changes query parameter values on the given property
-func (query *Query) SetStringParams(identifier propertyOrAlias, values ...string) error {
+func (query *Query) SetStringParams(identifier propertyOrAlias, values ...string) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetStringParams(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -247,8 +728,11 @@ func (query *Query) SetStringParams(identifier propertyOrAlias, values ...string
 }
 
 // SetStringParamsIn changes query parameter values on the given property
-func (query *Query) SetStringParamsIn(identifier propertyOrAlias, values ...string) error {
+func (query *Query) SetStringParamsIn(identifier propertyOrAlias, values ...string) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetStringParamsIn(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -276,8 +760,11 @@ func (query *Query) SetStringParamsIn(identifier propertyOrAlias, values ...stri
 }
 
 // SetInt64Params changes query parameter values on the given property
-func (query *Query) SetInt64Params(identifier propertyOrAlias, values ...int64) error {
+func (query *Query) SetInt64Params(identifier propertyOrAlias, values ...int64) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetInt64Params(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -314,8 +801,11 @@ func (query *Query) SetInt64Params(identifier propertyOrAlias, values ...int64)
 }
 
 // SetInt64ParamsIn changes query parameter values on the given property
-func (query *Query) SetInt64ParamsIn(identifier propertyOrAlias, values ...int64) error {
+func (query *Query) SetInt64ParamsIn(identifier propertyOrAlias, values ...int64) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetInt64ParamsIn(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -340,8 +830,11 @@ func (query *Query) SetInt64ParamsIn(identifier propertyOrAlias, values ...int64
 }
 
 // SetInt32ParamsIn changes query parameter values on the given property
-func (query *Query) SetInt32ParamsIn(identifier propertyOrAlias, values ...int32) error {
+func (query *Query) SetInt32ParamsIn(identifier propertyOrAlias, values ...int32) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetInt32ParamsIn(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -366,8 +859,11 @@ func (query *Query) SetInt32ParamsIn(identifier propertyOrAlias, values ...int32
 }
 
 // SetFloat64Params changes query parameter values on the given property
-func (query *Query) SetFloat64Params(identifier propertyOrAlias, values ...float64) error {
+func (query *Query) SetFloat64Params(identifier propertyOrAlias, values ...float64) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetFloat64Params(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
@@ -405,8 +901,11 @@ func (query *Query) SetFloat64Params(identifier propertyOrAlias, values ...float
 }
 
 // SetBytesParams changes query parameter values on the given property
-func (query *Query) SetBytesParams(identifier propertyOrAlias, values ...[]byte) error {
+func (query *Query) SetBytesParams(identifier propertyOrAlias, values ...[]byte) (err error) {
 	defer runtime.KeepAlive(query)
+	defer query.rememberParams(&err, identifier, func(query *Query) error {
+		return query.SetBytesParams(identifier, values...)
+	})
 
 	if err := query.checkIdentifier(identifier); err != nil {
 		return err
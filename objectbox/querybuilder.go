@@ -27,6 +27,9 @@ import (
 	"runtime"
 	"strings"
 	"unsafe"
+
+	"github.com/google/flatbuffers/go"
+	"github.com/objectbox/objectbox-go/objectbox/fbutils"
 )
 
 // QueryBuilder is an internal class; use Box.Query instead.
@@ -38,6 +41,30 @@ type QueryBuilder struct {
 	innerBuilders []*QueryBuilder
 	orderFlags    map[TypeId]C.OBXOrderFlags
 
+	// properties in the order they were first used in an order condition, i.e. by their precedence
+	orderProperties []TypeId
+
+	// conditions on properties as they're added, used to create a QueryPlan
+	planConditions []QueryPlanCondition
+
+	// whether the objects matching the condition added last can be looked up using an index, see createPlan()
+	planIndex planIndex
+
+	// whether the conditions currently being added are negated, see Not()
+	negated bool
+
+	// conditions added as a part of a negated condition replaced by multiple native ones, e.g. NOT(a > 5)
+	expandedConditions []QueryPlanCondition
+
+	// conditions evaluated in Go on the FlatBuffers data of the objects matching the native ones, see match()
+	matchers []func(bytes []byte) bool
+
+	// how many OR combinations the conditions currently being added are nested in
+	anyDepth int
+
+	// whether this is an inner builder, i.e. the conditions apply to the objects of a linked entity
+	linked bool
+
 	// The first error that occurred during a any of the calls on the query builder
 	Err error
 }
@@ -69,6 +96,7 @@ func (qb *QueryBuilder) newInnerBuilder(typeId TypeId, cqb *C.OBX_query_builder)
 		cqb:        cqb,
 		typeId:     typeId,
 		orderFlags: make(map[TypeId]C.OBXOrderFlags),
+		linked:     true,
 	}
 
 	qb.innerBuilders = append(qb.innerBuilders, iqb)
@@ -106,8 +134,17 @@ func (qb *QueryBuilder) Close() error {
 
 // Build is called internally
 func (qb *QueryBuilder) Build(box *Box) (*Query, error) {
-	for propertyId, orderFlags := range qb.orderFlags {
-		qb.order(C.obx_schema_id(propertyId), orderFlags)
+	var order = make([]queryOrder, len(qb.orderProperties))
+	for k, propertyId := range qb.orderProperties {
+		order[k] = queryOrder{propertyId: propertyId, flags: qb.orderFlags[propertyId]}
+
+		// compare unsigned integers as such, otherwise values over math.MaxInt64 would be ordered as negative
+		if property := box.entity.propertyById(propertyId); property != nil &&
+			property.flags&C.OBXPropertyFlags_UNSIGNED != 0 {
+			order[k].flags = order[k].flags | C.OBXOrderFlags_UNSIGNED
+		}
+
+		qb.order(C.obx_schema_id(propertyId), order[k].flags)
 	}
 
 	if qb.Err != nil {
@@ -117,7 +154,9 @@ func (qb *QueryBuilder) Build(box *Box) (*Query, error) {
 	query := &Query{
 		objectBox: qb.objectBox,
 		box:       box,
-		entity:    qb.objectBox.getEntityById(qb.typeId),
+		entity:    box.entity,
+		order:     order,
+		matchers:  qb.matchers,
 	}
 
 	if err := cCallBool(func() bool {
@@ -133,6 +172,12 @@ func (qb *QueryBuilder) Build(box *Box) (*Query, error) {
 	// search all inner builders recursively and collect linked entity IDs
 	qb.setQueryLinkedEntityIds(query)
 
+	query.plan = qb.createPlan()
+
+	for builders := []*QueryBuilder{qb}; len(builders) > 0; builders = append(builders[1:], builders[0].innerBuilders...) {
+		query.expandedConditions = append(query.expandedConditions, builders[0].expandedConditions...)
+	}
+
 	return query, nil
 }
 
@@ -163,6 +208,11 @@ func (qb *QueryBuilder) LinkOneToMany(relation *RelationToOne, conditions []Cond
 		return qb.Err
 	}
 
+	if qb.negated {
+		qb.Err = errors.New("negating link conditions is not supported")
+		return qb.Err
+	}
+
 	// create a new "inner" query builder
 	var iqb *QueryBuilder
 
@@ -170,17 +220,14 @@ func (qb *QueryBuilder) LinkOneToMany(relation *RelationToOne, conditions []Cond
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	cRelPropertyId := C.obx_schema_id(relation.Property.Id)
 	// recognize whether it's a link or a backlink
 	if relation.Property.Entity.Id == qb.typeId && relation.Target.Id != qb.typeId {
 		// if property belongs to the entity of the "main" query builder & target is another entity, it's a link
 		// log.Printf("QB %p creating link to entity %d over property %d", qb, relation.Target.Id, relation.Property.Id)
-		iqb = qb.newInnerBuilder(relation.Target.Id, C.obx_qb_link_property(qb.cqb, cRelPropertyId))
+		iqb = qb.newInnerBuilder(relation.Target.Id, C.obx_qb_link_property(qb.cqb, C.obx_schema_id(relation.Property.Id)))
 	} else if relation.Property.Entity.Id != qb.typeId && relation.Target.Id == qb.typeId {
 		// if property is not from the same entity as this query builder but the target is, it's a backlink
-		// log.Printf("QB %p creating backlink from entity %d over property %d", qb, relation.Property.Entity.Id, relation.Property.Id)
-		cInnerQB := C.obx_qb_backlink_property(qb.cqb, C.obx_schema_id(relation.Property.Entity.Id), cRelPropertyId)
-		iqb = qb.newInnerBuilder(relation.Property.Entity.Id, cInnerQB)
+		return qb.BacklinkOneToMany(relation, conditions)
 	} else {
 		return errors.New("relation not recognized as either link or backlink")
 	}
@@ -198,6 +245,11 @@ func (qb *QueryBuilder) LinkManyToMany(relation *RelationToMany, conditions []Co
 		return qb.Err
 	}
 
+	if qb.negated {
+		qb.Err = errors.New("negating link conditions is not supported")
+		return qb.Err
+	}
+
 	// create a new "inner" query builder
 	var iqb *QueryBuilder
 
@@ -210,8 +262,7 @@ func (qb *QueryBuilder) LinkManyToMany(relation *RelationToMany, conditions []Co
 		//log.Printf("QB %p creating link to entity %d over relation %d", qb, relation.Target.Id, relation.Id)
 		iqb = qb.newInnerBuilder(relation.Target.Id, C.obx_qb_link_standalone(qb.cqb, C.obx_schema_id(relation.Id)))
 	} else if relation.Source.Id != qb.typeId && relation.Target.Id == qb.typeId {
-		//log.Printf("QB %p creating backlink from entity %d over relation %d", qb, relation.Source.Id, relation.Id)
-		iqb = qb.newInnerBuilder(relation.Source.Id, C.obx_qb_backlink_standalone(qb.cqb, C.obx_schema_id(relation.Id)))
+		return qb.BacklinkManyToMany(relation, conditions)
 	} else {
 		return errors.New("relation not recognized as either link or backlink")
 	}
@@ -222,6 +273,63 @@ func (qb *QueryBuilder) LinkManyToMany(relation *RelationToMany, conditions []Co
 	return iqb.applyConditions(conditions)
 }
 
+// BacklinkOneToMany is called internally
+func (qb *QueryBuilder) BacklinkOneToMany(relation *RelationToOne, conditions []Condition) error {
+	if qb.Err != nil {
+		return qb.Err
+	}
+
+	if qb.negated {
+		qb.Err = errors.New("negating link conditions is not supported")
+		return qb.Err
+	}
+
+	if relation.Target.Id != qb.typeId {
+		return fmt.Errorf("backlink target entity %d doesn't match the queried entity %d", relation.Target.Id, qb.typeId)
+	}
+
+	// for native calls/createError() in newInnerBuilder
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	// log.Printf("QB %p creating backlink from entity %d over property %d", qb, relation.Property.Entity.Id, relation.Property.Id)
+	cInnerQB := C.obx_qb_backlink_property(qb.cqb, C.obx_schema_id(relation.Property.Entity.Id), C.obx_schema_id(relation.Property.Id))
+	var iqb = qb.newInnerBuilder(relation.Property.Entity.Id, cInnerQB)
+	if iqb == nil {
+		return qb.Err // this has been set by newInnerBuilder()
+	}
+
+	return iqb.applyConditions(conditions)
+}
+
+// BacklinkManyToMany is called internally
+func (qb *QueryBuilder) BacklinkManyToMany(relation *RelationToMany, conditions []Condition) error {
+	if qb.Err != nil {
+		return qb.Err
+	}
+
+	if qb.negated {
+		qb.Err = errors.New("negating link conditions is not supported")
+		return qb.Err
+	}
+
+	if relation.Target.Id != qb.typeId {
+		return fmt.Errorf("backlink target entity %d doesn't match the queried entity %d", relation.Target.Id, qb.typeId)
+	}
+
+	// for native calls/createError() in newInnerBuilder
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	//log.Printf("QB %p creating backlink from entity %d over relation %d", qb, relation.Source.Id, relation.Id)
+	var iqb = qb.newInnerBuilder(relation.Source.Id, C.obx_qb_backlink_standalone(qb.cqb, C.obx_schema_id(relation.Id)))
+	if iqb == nil {
+		return qb.Err // this has been set by newInnerBuilder()
+	}
+
+	return iqb.applyConditions(conditions)
+}
+
 func (qb *QueryBuilder) order(propertyId C.obx_schema_id, flags C.OBXOrderFlags) {
 	if qb.Err == nil {
 		qb.Err = cCall(func() C.obx_err {
@@ -234,6 +342,10 @@ func (qb *QueryBuilder) order(propertyId C.obx_schema_id, flags C.OBXOrderFlags)
 // if value is true, the flag is set, otherwise the flag is cleared (unset)
 func (qb *QueryBuilder) setOrderFlag(property *BaseProperty, flag C.OBXOrderFlags, value bool) error {
 	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+		if _, exists := qb.orderFlags[property.Id]; !exists {
+			qb.orderProperties = append(qb.orderProperties, property.Id)
+		}
+
 		if value {
 			// set the flag
 			qb.orderFlags[property.Id] = qb.orderFlags[property.Id] | flag
@@ -245,6 +357,37 @@ func (qb *QueryBuilder) setOrderFlag(property *BaseProperty, flag C.OBXOrderFlag
 	return qb.Err
 }
 
+// orderBy sets all order flags of the given property at once, replacing the previously set ones
+func (qb *QueryBuilder) orderBy(property propertyOrAlias, flags C.OBXOrderFlags) error {
+	if qb.Err != nil {
+		return qb.Err
+	}
+
+	if alias := property.alias(); alias != nil {
+		qb.Err = fmt.Errorf("OrderBy() requires a property, alias \"%s\" given", *alias)
+		return qb.Err
+	}
+
+	if !qb.checkEntityId(property.entityId()) {
+		return qb.Err
+	}
+
+	var prop = qb.objectBox.getEntityById(qb.typeId).propertyById(property.propertyId())
+	if prop == nil {
+		qb.Err = fmt.Errorf("property %d not found in entity %d", property.propertyId(), qb.typeId)
+		return qb.Err
+	} else if prop.typ == C.OBXPropertyType_StringVector {
+		qb.Err = fmt.Errorf("ordering by a string vector property %s is not supported", prop.name)
+		return qb.Err
+	}
+
+	if _, exists := qb.orderFlags[prop.id]; !exists {
+		qb.orderProperties = append(qb.orderProperties, prop.id)
+	}
+	qb.orderFlags[prop.id] = flags
+	return nil
+}
+
 func (qb *QueryBuilder) orderAsc(property *BaseProperty) error {
 	return qb.setOrderFlag(property, C.OBXOrderFlags_DESCENDING, false)
 }
@@ -296,6 +439,50 @@ func (qb *QueryBuilder) checkEntityId(entityId TypeId) bool {
 	return false
 }
 
+// checkProperty checks the property belongs to the queried entity and records the condition for the QueryPlan.
+// Set useIndex if the condition can be served by an index, in case the property is indexed.
+func (qb *QueryBuilder) checkProperty(property *BaseProperty, operation string, useIndex bool) bool {
+	if !qb.checkEntityId(property.Entity.Id) {
+		return false
+	}
+
+	var condition = QueryPlanCondition{
+		EntityId:   property.Entity.Id,
+		PropertyId: property.Id,
+		Operation:  operation,
+	}
+
+	if entity := qb.objectBox.entitiesById[property.Entity.Id]; entity != nil {
+		for _, prop := range entity.properties {
+			if prop.id == property.Id {
+				condition.Property = prop.name
+				condition.Indexed = prop.flags&(C.OBXPropertyFlags_ID|C.OBXPropertyFlags_INDEXED|
+					C.OBXPropertyFlags_INDEX_HASH|C.OBXPropertyFlags_INDEX_HASH64) != 0
+				break
+			}
+		}
+	}
+	condition.UsesIndex = condition.Indexed && useIndex
+
+	qb.planConditions = append(qb.planConditions, condition)
+	return true
+}
+
+// createPlan collects the recorded conditions of this builder and all its inner (link) builders
+func (qb *QueryBuilder) createPlan() *QueryPlan {
+	// qb.planIndex is the result of the root condition (or the implicit AND of multiple root conditions)
+	var plan = &QueryPlan{FullScan: qb.planIndex != planIndexUsed}
+
+	var builders = []*QueryBuilder{qb}
+	for len(builders) > 0 {
+		var builder = builders[0]
+		builders = append(builders[1:], builder.innerBuilders...)
+		plan.Conditions = append(plan.Conditions, builder.planConditions...)
+	}
+
+	return plan
+}
+
 func (qb *QueryBuilder) getConditionId(cid C.obx_qb_cond) ConditionId {
 	if cid == 0 {
 		// we only need to check & store the error if cid is 0, otherwise there can't be any error
@@ -305,6 +492,57 @@ func (qb *QueryBuilder) getConditionId(cid C.obx_qb_cond) ConditionId {
 	return ConditionId(cid)
 }
 
+// negate runs fn, which adds the complement of a negated condition, with the negation turned off
+func (qb *QueryBuilder) negate(fn func() (ConditionId, error)) (ConditionId, error) {
+	qb.negated = false
+	defer func() { qb.negated = true }()
+	return fn()
+}
+
+func (qb *QueryBuilder) negationNotSupported(operation string) (ConditionId, error) {
+	if qb.Err == nil {
+		qb.Err = fmt.Errorf("negating %s conditions is not supported", operation)
+	}
+	return 0, qb.Err
+}
+
+// anyOf adds all the conditions and combines them using OR
+func (qb *QueryBuilder) anyOf(fns ...func() (ConditionId, error)) (ConditionId, error) {
+	ids, err := qb.conditionIds(fns)
+	if err != nil {
+		return 0, err
+	}
+	return qb.Any(ids)
+}
+
+// allOf adds all the conditions and combines them using AND
+func (qb *QueryBuilder) allOf(fns ...func() (ConditionId, error)) (ConditionId, error) {
+	ids, err := qb.conditionIds(fns)
+	if err != nil {
+		return 0, err
+	}
+	return qb.All(ids)
+}
+
+func (qb *QueryBuilder) conditionIds(fns []func() (ConditionId, error)) ([]ConditionId, error) {
+	if len(fns) == 0 {
+		if qb.Err == nil {
+			qb.Err = errors.New("can't combine an empty list of conditions")
+		}
+		return nil, qb.Err
+	}
+
+	var ids = make([]ConditionId, len(fns))
+	for k, fn := range fns {
+		cid, err := fn()
+		if err != nil {
+			return nil, err
+		}
+		ids[k] = cid
+	}
+	return ids, nil
+}
+
 // Alias sets an alias for the last created condition
 func (qb *QueryBuilder) Alias(alias string) error {
 	if qb.Err == nil {
@@ -342,9 +580,15 @@ func (qb *QueryBuilder) All(ids []ConditionId) (ConditionId, error) {
 
 // IsNil is called internally
 func (qb *QueryBuilder) IsNil(property *BaseProperty) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.IsNotNil(property)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IsNil", false) {
 		cid = qb.getConditionId(C.obx_qb_null(qb.cqb, C.obx_schema_id(property.Id)))
 	}
 
@@ -353,9 +597,15 @@ func (qb *QueryBuilder) IsNil(property *BaseProperty) (ConditionId, error) {
 
 // IsNotNil is called internally
 func (qb *QueryBuilder) IsNotNil(property *BaseProperty) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.IsNil(property)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IsNotNil", false) {
 		cid = qb.getConditionId(C.obx_qb_not_null(qb.cqb, C.obx_schema_id(property.Id)))
 	}
 
@@ -364,9 +614,15 @@ func (qb *QueryBuilder) IsNotNil(property *BaseProperty) (ConditionId, error) {
 
 // StringEquals is called internally
 func (qb *QueryBuilder) StringEquals(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.StringNotEquals(property, value, caseSensitive)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringEquals", caseSensitive) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_equal(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -377,9 +633,20 @@ func (qb *QueryBuilder) StringEquals(property *BaseProperty, value string, caseS
 
 // StringIn is called internally
 func (qb *QueryBuilder) StringIn(property *BaseProperty, values []string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			var fns = make([]func() (ConditionId, error), len(values))
+			for k := range values {
+				var value = values[k]
+				fns[k] = func() (ConditionId, error) { return qb.StringNotEquals(property, value, caseSensitive) }
+			}
+			return qb.allOf(fns...)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringIn", caseSensitive) {
 		if len(values) > 0 {
 			cStringArray := goStringArrayToC(values)
 			defer cStringArray.free()
@@ -394,9 +661,13 @@ func (qb *QueryBuilder) StringIn(property *BaseProperty, values []string, caseSe
 
 // StringContains is called internally
 func (qb *QueryBuilder) StringContains(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringContains")
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringContains", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_contains(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -407,9 +678,13 @@ func (qb *QueryBuilder) StringContains(property *BaseProperty, value string, cas
 
 // StringHasPrefix is called internally
 func (qb *QueryBuilder) StringHasPrefix(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringHasPrefix")
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringHasPrefix", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_starts_with(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -420,9 +695,13 @@ func (qb *QueryBuilder) StringHasPrefix(property *BaseProperty, value string, ca
 
 // StringHasSuffix is called internally
 func (qb *QueryBuilder) StringHasSuffix(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringHasSuffix")
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringHasSuffix", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_ends_with(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -433,9 +712,15 @@ func (qb *QueryBuilder) StringHasSuffix(property *BaseProperty, value string, ca
 
 // StringNotEquals is called internally
 func (qb *QueryBuilder) StringNotEquals(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.StringEquals(property, value, caseSensitive)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringNotEquals", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_not_equal(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -446,9 +731,15 @@ func (qb *QueryBuilder) StringNotEquals(property *BaseProperty, value string, ca
 
 // StringGreater is called internally
 func (qb *QueryBuilder) StringGreater(property *BaseProperty, value string, caseSensitive bool, withEqual bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.StringLess(property, value, caseSensitive, !withEqual)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringGreater", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_greater(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive), C.bool(withEqual)))
@@ -459,9 +750,15 @@ func (qb *QueryBuilder) StringGreater(property *BaseProperty, value string, case
 
 // StringLess is called internally
 func (qb *QueryBuilder) StringLess(property *BaseProperty, value string, caseSensitive bool, withEqual bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.StringGreater(property, value, caseSensitive, !withEqual)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringLess", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_string_less(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive), C.bool(withEqual)))
@@ -472,9 +769,13 @@ func (qb *QueryBuilder) StringLess(property *BaseProperty, value string, caseSen
 
 // StringVectorContains is called internally
 func (qb *QueryBuilder) StringVectorContains(property *BaseProperty, value string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringVectorContains")
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "StringVectorContains", false) {
 		cvalue := C.CString(value)
 		defer C.free(unsafe.Pointer(cvalue))
 		cid = qb.getConditionId(C.obx_qb_strings_contain(qb.cqb, C.obx_schema_id(property.Id), cvalue, C.bool(caseSensitive)))
@@ -485,9 +786,17 @@ func (qb *QueryBuilder) StringVectorContains(property *BaseProperty, value strin
 
 // IntBetween is called internally
 func (qb *QueryBuilder) IntBetween(property *BaseProperty, value1 int64, value2 int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.IntLess(property, value1) },
+				func() (ConditionId, error) { return qb.IntGreater(property, value2) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IntBetween", false) {
 		cid = qb.getConditionId(C.obx_qb_int_between(qb.cqb, C.obx_schema_id(property.Id), C.int64_t(value1), C.int64_t(value2)))
 	}
 
@@ -496,9 +805,15 @@ func (qb *QueryBuilder) IntBetween(property *BaseProperty, value1 int64, value2
 
 // IntEqual is called internally
 func (qb *QueryBuilder) IntEqual(property *BaseProperty, value int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.IntNotEqual(property, value)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IntEqual", true) {
 		cid = qb.getConditionId(C.obx_qb_int_equal(qb.cqb, C.obx_schema_id(property.Id), C.int64_t(value)))
 	}
 
@@ -507,9 +822,15 @@ func (qb *QueryBuilder) IntEqual(property *BaseProperty, value int64) (Condition
 
 // IntNotEqual is called internally
 func (qb *QueryBuilder) IntNotEqual(property *BaseProperty, value int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.IntEqual(property, value)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IntNotEqual", false) {
 		cid = qb.getConditionId(C.obx_qb_int_not_equal(qb.cqb, C.obx_schema_id(property.Id), C.int64_t(value)))
 	}
 
@@ -518,9 +839,17 @@ func (qb *QueryBuilder) IntNotEqual(property *BaseProperty, value int64) (Condit
 
 // IntGreater is called internally
 func (qb *QueryBuilder) IntGreater(property *BaseProperty, value int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.IntLess(property, value) },
+				func() (ConditionId, error) { return qb.IntEqual(property, value) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IntGreater", false) {
 		cid = qb.getConditionId(C.obx_qb_int_greater(qb.cqb, C.obx_schema_id(property.Id), C.int64_t(value)))
 	}
 
@@ -529,9 +858,17 @@ func (qb *QueryBuilder) IntGreater(property *BaseProperty, value int64) (Conditi
 
 // IntLess is called internally
 func (qb *QueryBuilder) IntLess(property *BaseProperty, value int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.IntGreater(property, value) },
+				func() (ConditionId, error) { return qb.IntEqual(property, value) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "IntLess", false) {
 		cid = qb.getConditionId(C.obx_qb_int_less(qb.cqb, C.obx_schema_id(property.Id), C.int64_t(value)))
 	}
 
@@ -540,9 +877,15 @@ func (qb *QueryBuilder) IntLess(property *BaseProperty, value int64) (ConditionI
 
 // Int64In is called internally
 func (qb *QueryBuilder) Int64In(property *BaseProperty, values []int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.Int64NotIn(property, values)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "Int64In", true) {
 		cid = qb.getConditionId(C.obx_qb_int64_in(qb.cqb, C.obx_schema_id(property.Id), goInt64ArrayToC(values), C.int(len(values))))
 	}
 
@@ -551,9 +894,15 @@ func (qb *QueryBuilder) Int64In(property *BaseProperty, values []int64) (Conditi
 
 // Int64NotIn is called internally
 func (qb *QueryBuilder) Int64NotIn(property *BaseProperty, values []int64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.Int64In(property, values)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "Int64NotIn", false) {
 		cid = qb.getConditionId(C.obx_qb_int64_not_in(qb.cqb, C.obx_schema_id(property.Id), goInt64ArrayToC(values), C.int(len(values))))
 	}
 
@@ -562,9 +911,15 @@ func (qb *QueryBuilder) Int64NotIn(property *BaseProperty, values []int64) (Cond
 
 // Int32In is called internally
 func (qb *QueryBuilder) Int32In(property *BaseProperty, values []int32) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.Int32NotIn(property, values)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "Int32In", true) {
 		cid = qb.getConditionId(C.obx_qb_int32_in(qb.cqb, C.obx_schema_id(property.Id), goInt32ArrayToC(values), C.int(len(values))))
 	}
 
@@ -573,9 +928,15 @@ func (qb *QueryBuilder) Int32In(property *BaseProperty, values []int32) (Conditi
 
 // Int32NotIn is called internally
 func (qb *QueryBuilder) Int32NotIn(property *BaseProperty, values []int32) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.Int32In(property, values)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "Int32NotIn", false) {
 		cid = qb.getConditionId(C.obx_qb_int32_not_in(qb.cqb, C.obx_schema_id(property.Id), goInt32ArrayToC(values), C.int(len(values))))
 	}
 
@@ -584,9 +945,17 @@ func (qb *QueryBuilder) Int32NotIn(property *BaseProperty, values []int32) (Cond
 
 // DoubleGreater is called internally
 func (qb *QueryBuilder) DoubleGreater(property *BaseProperty, value float64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleLess(property, value) },
+				func() (ConditionId, error) { return qb.DoubleBetween(property, value, value) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "DoubleGreater", false) {
 		cid = qb.getConditionId(C.obx_qb_double_greater(qb.cqb, C.obx_schema_id(property.Id), C.double(value)))
 	}
 
@@ -595,9 +964,17 @@ func (qb *QueryBuilder) DoubleGreater(property *BaseProperty, value float64) (Co
 
 // DoubleLess is called internally
 func (qb *QueryBuilder) DoubleLess(property *BaseProperty, value float64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleGreater(property, value) },
+				func() (ConditionId, error) { return qb.DoubleBetween(property, value, value) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "DoubleLess", false) {
 		cid = qb.getConditionId(C.obx_qb_double_less(qb.cqb, C.obx_schema_id(property.Id), C.double(value)))
 	}
 
@@ -606,9 +983,17 @@ func (qb *QueryBuilder) DoubleLess(property *BaseProperty, value float64) (Condi
 
 // DoubleBetween is called internally
 func (qb *QueryBuilder) DoubleBetween(property *BaseProperty, valueA float64, valueB float64) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleLess(property, valueA) },
+				func() (ConditionId, error) { return qb.DoubleGreater(property, valueB) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "DoubleBetween", false) {
 		cid = qb.getConditionId(C.obx_qb_double_between(qb.cqb, C.obx_schema_id(property.Id), C.double(valueA), C.double(valueB)))
 	}
 
@@ -617,9 +1002,17 @@ func (qb *QueryBuilder) DoubleBetween(property *BaseProperty, valueA float64, va
 
 // BytesEqual is called internally
 func (qb *QueryBuilder) BytesEqual(property *BaseProperty, value []byte) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.BytesLess(property, value, false) },
+				func() (ConditionId, error) { return qb.BytesGreater(property, value, false) })
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "BytesEqual", true) {
 		cid = qb.getConditionId(C.obx_qb_bytes_equal(qb.cqb, C.obx_schema_id(property.Id), cBytesPtr(value), C.size_t(len(value))))
 	}
 
@@ -628,9 +1021,15 @@ func (qb *QueryBuilder) BytesEqual(property *BaseProperty, value []byte) (Condit
 
 // BytesGreater is called internally
 func (qb *QueryBuilder) BytesGreater(property *BaseProperty, value []byte, withEqual bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.BytesLess(property, value, !withEqual)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "BytesGreater", false) {
 		cid = qb.getConditionId(C.obx_qb_bytes_greater(qb.cqb, C.obx_schema_id(property.Id), cBytesPtr(value), C.size_t(len(value)), C.bool(withEqual)))
 	}
 
@@ -639,11 +1038,173 @@ func (qb *QueryBuilder) BytesGreater(property *BaseProperty, value []byte, withE
 
 // BytesLess is called internally
 func (qb *QueryBuilder) BytesLess(property *BaseProperty, value []byte, withEqual bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			return qb.BytesGreater(property, value, !withEqual)
+		})
+	}
+
 	var cid ConditionId
 
-	if qb.Err == nil && qb.checkEntityId(property.Entity.Id) {
+	if qb.Err == nil && qb.checkProperty(property, "BytesLess", false) {
 		cid = qb.getConditionId(C.obx_qb_bytes_less(qb.cqb, C.obx_schema_id(property.Id), cBytesPtr(value), C.size_t(len(value)), C.bool(withEqual)))
 	}
 
 	return cid, qb.Err
 }
+
+// match adds a condition the core doesn't support natively, evaluated in Go on the FlatBuffers data of each object
+// matching the native conditions. Therefore, it can't be used inside an OR combination or in a link to another entity.
+func (qb *QueryBuilder) match(property *BaseProperty, operation string, fn func(table *flatbuffers.Table, slot flatbuffers.VOffsetT) bool) (ConditionId, error) {
+	if qb.Err == nil && qb.checkProperty(property, operation, false) {
+		if qb.linked || qb.anyDepth > 0 {
+			qb.Err = fmt.Errorf("%s can't be used inside Any() or a link to another entity", operation)
+			return 0, qb.Err
+		}
+
+		var negated = qb.negated
+		var slot = flatbuffers.VOffsetT(4 + 2*(property.Id-1))
+		qb.matchers = append(qb.matchers, func(bytes []byte) bool {
+			var table = &flatbuffers.Table{Bytes: bytes, Pos: flatbuffers.GetUOffsetT(bytes)}
+			return fn(table, slot) != negated
+		})
+	}
+
+	return conditionIdFakeMatch, qb.Err
+}
+
+// vectorLength adds a Go-side condition on the number of elements of a vector property, nil counting as empty
+func (qb *QueryBuilder) vectorLength(property *BaseProperty, operation string, fn func(length int) bool) (ConditionId, error) {
+	return qb.match(property, operation, func(table *flatbuffers.Table, slot flatbuffers.VOffsetT) bool {
+		var length int
+		if o := flatbuffers.UOffsetT(table.Offset(slot)); o != 0 {
+			length = table.VectorLen(o)
+		}
+		return fn(length)
+	})
+}
+
+// VectorLengthEquals is called internally
+func (qb *QueryBuilder) VectorLengthEquals(property *BaseProperty, length int) (ConditionId, error) {
+	return qb.vectorLength(property, "VectorLengthEquals", func(value int) bool { return value == length })
+}
+
+// VectorLengthGreater is called internally
+func (qb *QueryBuilder) VectorLengthGreater(property *BaseProperty, length int) (ConditionId, error) {
+	return qb.vectorLength(property, "VectorLengthGreater", func(value int) bool { return value > length })
+}
+
+// VectorLengthLess is called internally
+func (qb *QueryBuilder) VectorLengthLess(property *BaseProperty, length int) (ConditionId, error) {
+	return qb.vectorLength(property, "VectorLengthLess", func(value int) bool { return value < length })
+}
+
+// StringVectorContainsAny is called internally
+func (qb *QueryBuilder) StringVectorContainsAny(property *BaseProperty, values []string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringVectorContainsAny")
+	}
+
+	// there's no native constant condition; "nil AND not nil" doesn't match any object
+	if len(values) == 0 {
+		return qb.allOf(
+			func() (ConditionId, error) { return qb.IsNil(property) },
+			func() (ConditionId, error) { return qb.IsNotNil(property) })
+	}
+
+	var fns = make([]func() (ConditionId, error), len(values))
+	for k, value := range values {
+		var value = value
+		fns[k] = func() (ConditionId, error) { return qb.StringVectorContains(property, value, caseSensitive) }
+	}
+	return qb.anyOf(fns...)
+}
+
+// StringVectorContainsAll is called internally
+func (qb *QueryBuilder) StringVectorContainsAll(property *BaseProperty, values []string, caseSensitive bool) (ConditionId, error) {
+	if qb.negated {
+		return qb.negationNotSupported("StringVectorContainsAll")
+	}
+
+	// there's no native constant condition; "nil OR not nil" matches all objects
+	if len(values) == 0 {
+		return qb.anyOf(
+			func() (ConditionId, error) { return qb.IsNil(property) },
+			func() (ConditionId, error) { return qb.IsNotNil(property) })
+	}
+
+	var fns = make([]func() (ConditionId, error), len(values))
+	for k, value := range values {
+		var value = value
+		fns[k] = func() (ConditionId, error) { return qb.StringVectorContains(property, value, caseSensitive) }
+	}
+	return qb.allOf(fns...)
+}
+
+// StringVectorContainsPrefix is called internally
+func (qb *QueryBuilder) StringVectorContainsPrefix(property *BaseProperty, prefix string, caseSensitive bool) (ConditionId, error) {
+	if !caseSensitive {
+		prefix = strings.ToLower(prefix)
+	}
+
+	return qb.match(property, "StringVectorContainsPrefix", func(table *flatbuffers.Table, slot flatbuffers.VOffsetT) bool {
+		for _, element := range fbutils.GetStringVectorSlot(table, slot) {
+			if !caseSensitive {
+				element = strings.ToLower(element)
+			}
+			if strings.HasPrefix(element, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// BytesHasPrefix is called internally
+func (qb *QueryBuilder) BytesHasPrefix(property *BaseProperty, prefix []byte) (ConditionId, error) {
+	// values with the prefix form a range: [prefix, upper) where upper is the smallest value greater than all of them
+	var upper = bytesPrefixUpperBound(prefix)
+
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) {
+			if upper == nil {
+				return qb.BytesLess(property, prefix, false)
+			}
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.BytesLess(property, prefix, false) },
+				func() (ConditionId, error) { return qb.BytesGreater(property, upper, true) })
+		})
+	}
+
+	if upper == nil {
+		return qb.BytesGreater(property, prefix, true)
+	}
+	return qb.allOf(
+		func() (ConditionId, error) { return qb.BytesGreater(property, prefix, true) },
+		func() (ConditionId, error) { return qb.BytesLess(property, upper, false) })
+}
+
+// BytesIsEmpty is called internally
+func (qb *QueryBuilder) BytesIsEmpty(property *BaseProperty) (ConditionId, error) {
+	// nothing is less than an empty value so only values other than nil and empty are greater than it
+	if qb.negated {
+		return qb.negate(func() (ConditionId, error) { return qb.BytesGreater(property, nil, false) })
+	}
+
+	return qb.anyOf(
+		func() (ConditionId, error) { return qb.IsNil(property) },
+		func() (ConditionId, error) { return qb.BytesEqual(property, nil) })
+}
+
+// bytesPrefixUpperBound returns the prefix with the last byte lower than 0xFF incremented (and the rest cut off),
+// or nil if there's no such byte, i.e. there's no upper bound for the values with the given prefix
+func bytesPrefixUpperBound(prefix []byte) []byte {
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xFF {
+			var upper = append([]byte{}, prefix[:i+1]...)
+			upper[i]++
+			return upper
+		}
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FilterSpec is a JSON-serializable description of query conditions, e.g. to persist saved searches or to receive
+// filters from REST clients. It's resolved against an entity's properties using Box.FilterSpecCondition() or
+// Box.QueryFilterSpec() and results in native query conditions; not to be confused with Query.Filter(), which sets
+// a Go function deciding about each object.
+//
+// Each filter (node) is either a combination of other filters, using exactly one of All, Any or Not,
+// or a comparison of a property with a value, using Prop, Op and Value. For example:
+//
+//	{"all": [
+//	    {"prop": "Priority", "op": "gt", "value": 3},
+//	    {"any": [{"prop": "Text", "op": "contains", "value": "bug"}, {"prop": "Tags", "op": "has", "value": "urgent"}]},
+//	    {"not": {"prop": "DateFinished", "op": "notNull"}}
+//	]}
+//
+// Supported operations (depending on the property type, see Box.QueryString() for details):
+//
+//	eq, ne, lt, lte, gt, gte     value is a number, string or boolean
+//	between                      value is an array of two numbers
+//	in                           value is an array of numbers or strings
+//	contains, startsWith, endsWith   value is a string
+//	has                          value is a string, for string vector properties
+//	isNull, notNull              no value
+type FilterSpec struct {
+	All []*FilterSpec `json:"all,omitempty"`
+	Any []*FilterSpec `json:"any,omitempty"`
+	Not *FilterSpec   `json:"not,omitempty"`
+
+	Prop  string      `json:"prop,omitempty"`
+	Op    string      `json:"op,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// filterOperations maps FilterSpec.Op (lowercase) to the operators understood by queryComparison
+var filterOperations = map[string]string{
+	"eq":         "=",
+	"ne":         "!=",
+	"lt":         "<",
+	"lte":        "<=",
+	"gt":         ">",
+	"gte":        ">=",
+	"between":    "BETWEEN",
+	"in":         "IN",
+	"contains":   "CONTAINS",
+	"startswith": "STARTSWITH",
+	"endswith":   "ENDSWITH",
+	"has":        "HAS",
+	"isnull":     "IS NULL",
+	"notnull":    "IS NOT NULL",
+}
+
+// ParseFilterSpec parses a JSON encoded FilterSpec
+func ParseFilterSpec(data []byte) (*FilterSpec, error) {
+	var decoder = json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber() // keep large integers intact
+	decoder.DisallowUnknownFields()
+
+	var filter = &FilterSpec{}
+	if err := decoder.Decode(filter); err != nil {
+		return nil, fmt.Errorf("invalid filter: %s", err)
+	}
+	return filter, nil
+}
+
+// String returns the JSON representation of the filter
+func (filter *FilterSpec) String() string {
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return fmt.Sprintf("invalid filter: %s", err)
+	}
+	return string(data)
+}
+
+// FilterSpecCondition creates a condition from the given filter, resolving property names against this box's entity.
+// Returns an error for unknown properties, unsupported operations or values not matching the property type.
+func (box *Box) FilterSpecCondition(filter *FilterSpec) (Condition, error) {
+	if filter == nil {
+		return nil, errors.New("invalid filter: filter is nil")
+	}
+
+	condition, err := filter.condition(box.entity)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %s", err)
+	}
+	return condition, nil
+}
+
+// QueryFilterSpec creates a query from the given filter, see Box.FilterSpecCondition().
+// An empty filter (or nil) matches all objects.
+func (box *Box) QueryFilterSpec(filter *FilterSpec) (*Query, error) {
+	if filter == nil || filter.isEmpty() {
+		return box.QueryOrError()
+	}
+
+	condition, err := box.FilterSpecCondition(filter)
+	if err != nil {
+		return nil, err
+	}
+	return box.QueryOrError(condition)
+}
+
+func (filter *FilterSpec) isEmpty() bool {
+	return filter.All == nil && filter.Any == nil && filter.Not == nil && filter.Prop == "" && filter.Op == "" && filter.Value == nil
+}
+
+func (filter *FilterSpec) condition(entity *entity) (Condition, error) {
+	var kinds = 0
+	for _, set := range []bool{filter.All != nil, filter.Any != nil, filter.Not != nil, filter.Prop != ""} {
+		if set {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		return nil, fmt.Errorf("exactly one of all, any, not or prop must be given in %s", filter)
+	}
+
+	switch {
+	case filter.All != nil:
+		conditions, err := filterConditions(entity, filter.All)
+		if err != nil {
+			return nil, err
+		}
+		return All(conditions...), nil
+
+	case filter.Any != nil:
+		conditions, err := filterConditions(entity, filter.Any)
+		if err != nil {
+			return nil, err
+		}
+		return Any(conditions...), nil
+
+	case filter.Not != nil:
+		condition, err := filter.Not.condition(entity)
+		if err != nil {
+			return nil, err
+		}
+		return Not(condition), nil
+	}
+
+	return filter.comparison(entity)
+}
+
+func filterConditions(entity *entity, filters []*FilterSpec) ([]Condition, error) {
+	if len(filters) == 0 {
+		return nil, errors.New("all/any must contain at least one filter")
+	}
+
+	var conditions = make([]Condition, len(filters))
+	for k, filter := range filters {
+		if filter == nil {
+			return nil, errors.New("all/any must not contain null filters")
+		}
+
+		var err error
+		if conditions[k], err = filter.condition(entity); err != nil {
+			return nil, err
+		}
+	}
+	return conditions, nil
+}
+
+func (filter *FilterSpec) comparison(entity *entity) (Condition, error) {
+	var property *property
+	for _, p := range entity.properties {
+		if p.name == filter.Prop {
+			property = p
+			break
+		}
+	}
+	if property == nil {
+		return nil, fmt.Errorf("unknown property %s in entity %s", filter.Prop, entity.name)
+	}
+
+	operator, found := filterOperations[strings.ToLower(filter.Op)]
+	if !found {
+		return nil, fmt.Errorf("unknown operation '%s' for property %s", filter.Op, filter.Prop)
+	}
+
+	queryProperty, err := property.queryProperty(entity)
+	if err != nil {
+		return nil, err
+	}
+
+	var comparison = &queryComparison{
+		name:     property.name,
+		property: queryProperty,
+		offset:   -1,
+		operator: operator,
+	}
+
+	// check the number of values and convert them to tokens, as if they were parsed from a query string
+	var values []interface{}
+	switch operator {
+	case "IS NULL", "IS NOT NULL":
+		if filter.Value != nil {
+			return nil, comparison.errorf("no value expected")
+		}
+	case "BETWEEN", "IN":
+		var array = reflect.ValueOf(filter.Value)
+		if array.Kind() != reflect.Slice && array.Kind() != reflect.Array {
+			return nil, comparison.errorf("value must be an array")
+		} else if operator == "BETWEEN" && array.Len() != 2 {
+			return nil, comparison.errorf("value must be an array of two numbers")
+		} else if array.Len() == 0 {
+			return nil, comparison.errorf("value must be a non-empty array")
+		}
+		for i := 0; i < array.Len(); i++ {
+			values = append(values, array.Index(i).Interface())
+		}
+	default:
+		if filter.Value == nil {
+			return nil, comparison.errorf("value is missing")
+		}
+		values = []interface{}{filter.Value}
+	}
+
+	for _, value := range values {
+		token, err := filterValueToken(value)
+		if err != nil {
+			return nil, comparison.errorf("%s", err)
+		}
+		comparison.values = append(comparison.values, token)
+	}
+
+	return comparison.condition()
+}
+
+func filterValueToken(value interface{}) (queryToken, error) {
+	var token = queryToken{offset: -1}
+	switch v := value.(type) {
+	case string:
+		token.kind = queryTokenString
+		token.text = v
+	case bool:
+		token.kind = queryTokenIdentifier
+		token.text = strconv.FormatBool(v)
+	case json.Number:
+		token.kind = queryTokenNumber
+		token.text = v.String()
+	case float64:
+		token.kind = queryTokenNumber
+		token.text = strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		token.kind = queryTokenNumber
+		token.text = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		token.kind = queryTokenNumber
+		token.text = fmt.Sprint(v)
+	default:
+		return token, fmt.Errorf("unsupported value %v of type %T", value, value)
+	}
+	return token, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+import (
+	"fmt"
+)
+
+// OrderFlag adjusts the order set by OrderBy(); multiple flags can be given at once
+type OrderFlag uint32
+
+const (
+	// OrderDescending reverses the order, i.e. from the largest to the smallest value
+	OrderDescending OrderFlag = 1
+
+	// OrderCaseSensitive makes ordering by a string property case sensitive; it's case insensitive by default
+	OrderCaseSensitive OrderFlag = 2
+
+	// OrderUnsigned compares integers as unsigned; it's applied automatically to unsigned properties, e.g. uint64
+	OrderUnsigned OrderFlag = 4
+
+	// OrderNilLast puts objects with a nil value of the property at the end of the result set
+	OrderNilLast OrderFlag = 8
+
+	// OrderNilAsZero treats a nil value of the property the same as zero (or an empty string)
+	OrderNilAsZero OrderFlag = 16
+)
+
+// OrderBy sorts the query results by the given property of any type except a string vector.
+// Without flags, the order is ascending and case insensitive, and unsigned properties are compared as unsigned.
+//
+// A query can be ordered by multiple properties: the precedence follows the order of their first use among the
+// conditions, i.e. the first property is the primary sort key, the second one only decides between objects with the
+// same value of the first one, etc. Objects equal in all order properties are returned in an unspecified order.
+// Calling OrderBy() again on the same property replaces its flags (including those set by Order*() methods of the
+// property) but keeps its precedence. Use Query.SetOrderParams() to change the flags of an existing query.
+//
+// For example, to find people ordered by their last name and then from the oldest:
+//
+//	box.Query(objectbox.OrderBy(Person_.LastName), objectbox.OrderBy(Person_.Age, objectbox.OrderDescending))
+func OrderBy(property propertyOrAlias, flags ...OrderFlag) Condition {
+	return &orderClosure{
+		apply: func(qb *QueryBuilder) error {
+			return qb.orderBy(property, orderFlags(flags))
+		},
+	}
+}
+
+func orderFlags(flags []OrderFlag) C.OBXOrderFlags {
+	var result C.OBXOrderFlags
+	for _, flag := range flags {
+		result = result | C.OBXOrderFlags(flag)
+	}
+	return result
+}
+
+// SetOrderParams changes the flags of a property the query is already ordered by, e.g. to switch between ascending
+// and descending order at runtime. The precedence of the order properties stays the same.
+// Because the order is fixed in a native query, the query is rebuilt from its conditions; parameters previously
+// changed using Set*Params() are applied to the rebuilt query as well.
+func (query *Query) SetOrderParams(property propertyOrAlias, flags ...OrderFlag) error {
+	if alias := property.alias(); alias != nil {
+		return fmt.Errorf("SetOrderParams() requires a property, alias \"%s\" given", *alias)
+	} else if property.entityId() != query.entity.id {
+		return fmt.Errorf("property from a different entity %d passed, expected %d", property.entityId(), query.entity.id)
+	}
+
+	var ordered bool
+	for _, order := range query.order {
+		if order.propertyId == property.propertyId() {
+			ordered = true
+		}
+	}
+	if !ordered {
+		return fmt.Errorf("the query isn't ordered by property %d; use OrderBy() when creating it", property.propertyId())
+	}
+
+	var conditions = append(query.orderedConditions(), OrderBy(property, flags...))
+	rebuilt, err := query.rebuild(conditions...)
+	if err != nil {
+		return err
+	}
+
+	query.closeMutex.Lock()
+	defer query.closeMutex.Unlock()
+
+	if query.cQuery == nil {
+		rebuilt.Close()
+		return query.errorClosed()
+	}
+
+	// swap the native queries so that the outdated one is closed together with the rebuilt query object
+	query.cQuery, rebuilt.cQuery = rebuilt.cQuery, query.cQuery
+	query.order = rebuilt.order
+	return rebuilt.Close()
+}
+
+// orderedConditions returns the conditions the query was created with, followed by its current order,
+// i.e. including changes made by SetOrderParams()
+func (query *Query) orderedConditions() []Condition {
+	var conditions = make([]Condition, 0, len(query.conditions)+len(query.order))
+	conditions = append(conditions, query.conditions...)
+	for _, order := range query.order {
+		var order = order
+		conditions = append(conditions, &orderClosure{
+			apply: func(qb *QueryBuilder) error {
+				var property = &BaseProperty{Id: order.propertyId, Entity: &Entity{Id: query.entity.id}}
+				return qb.orderBy(property, order.flags)
+			},
+		})
+	}
+	return conditions
+}
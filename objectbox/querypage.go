@@ -0,0 +1,376 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+*/
+import "C"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// Page returns at most size objects matching the query, following the object the afterToken was issued for,
+// and a token to request the next page with. Pass an empty afterToken to get the first page. The returned token
+// is empty when there are no more objects. Cast the objects slice as with Find() or use the generated Page() instead.
+//
+// As opposed to Offset() and Limit(), the position is not counted but encoded in the token as the sort key (values
+// of the properties the query is ordered by) and the ID of the last returned object (used as a tie-breaker),
+// so each page is found efficiently and objects inserted or removed in the meantime don't shift the pages.
+// Tokens are opaque strings, stable across restarts, and can be used with any query with the same order.
+//
+// Notes:
+//   - the query offset and limit are ignored, the filter (see Filter()) is applied;
+//   - the query is rebuilt from its conditions, applying parameters changed using Set*Params() and SetOrderParams();
+//   - ordering by byte vector properties isn't supported, neither are nil values of the order properties.
+func (query *Query) Page(size uint64, afterToken string) (objects interface{}, nextToken string, err error) {
+	defer runtime.KeepAlive(query)
+
+	if query.cQuery == nil {
+		return nil, "", query.errorClosed()
+	} else if size == 0 {
+		return nil, "", errors.New("page size must be greater than zero")
+	}
+
+	var conditions = query.orderedConditions()
+
+	if afterToken != "" {
+		token, err := query.decodePageToken(afterToken)
+		if err != nil {
+			return nil, "", err
+		}
+
+		condition, err := query.pageCondition(token)
+		if err != nil {
+			return nil, "", err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	// always order by ID last so that the order is total, i.e. the sort key of the last object identifies the position
+	if !query.isOrderedById() {
+		conditions = append(conditions, query.idProperty().orderAsc())
+	}
+
+	pageQuery, err := query.rebuild(conditions...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer pageQuery.Close()
+
+	pageQuery.filter = query.filter
+
+	var count uint64
+	var lastObject interface{}
+	var lastBytes []byte
+	objects, err = pageQuery.readPage(size, func(object interface{}, bytes []byte) {
+		count++
+		lastObject = object
+		lastBytes = append(lastBytes[:0], bytes...)
+	})
+	if err != nil || count < size {
+		return objects, "", err
+	}
+
+	nextToken, err = query.encodePageToken(lastObject, lastBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects, nextToken, nil
+}
+
+// readPage reads at most size objects accepted by the query filter (if any),
+// passing each one together with its FlatBuffers data to fn
+func (query *Query) readPage(size uint64, fn func(object interface{}, bytes []byte)) (slice interface{}, err error) {
+	defer runtime.KeepAlive(query)
+
+	var box = query.box
+	if err := box.checkTx(); err != nil {
+		return nil, err
+	}
+
+	// without a filter, the native limit can be used, otherwise the visit stops after size accepted objects
+	var limit = size
+	if query.isFiltered() {
+		limit = 0
+	}
+
+	var binding = box.entity.binding
+	var count uint64
+	visitor, err := dataVisitorRegister(func(bytes []byte) bool {
+		if bytes == nil || !query.match(bytes) {
+			return true
+		}
+
+		object, err2 := binding.Load(box.ObjectBox, bytes)
+		if err2 != nil {
+			err = err2
+			return false
+		} else if query.filter != nil && !query.filter(object) {
+			return true
+		}
+
+		slice = binding.AppendToSlice(slice, object)
+		fn(object, bytes)
+		count++
+		return count < size
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer dataVisitorUnregister(visitor)
+
+	slice = binding.MakeSlice(int(size))
+
+	// see readUsingVisitor() for why a read transaction is necessary and why we need a separate error variable
+	var err2 = box.ObjectBox.RunInReadTx(func() error {
+		return cCall(func() C.obx_err {
+			return C.obx_query_visit(query.cQuery, dataVisitor, unsafe.Pointer(&visitor), 0, C.uint64_t(limit))
+		})
+	})
+
+	if err2 != nil {
+		return nil, err2
+	} else if err != nil {
+		return nil, err
+	}
+	return slice, nil
+}
+
+// pageToken is the (JSON) content of the token returned by Query.Page()
+type pageToken struct {
+	EntityId TypeId        `json:"e"`
+	Order    []string      `json:"o"` // property ID and order flags of each order condition, e.g. "3:1"
+	Keys     []interface{} `json:"k"` // values of the order properties of the last object
+	Id       uint64        `json:"i"` // ID of the last object
+}
+
+func (query *Query) pageTokenOrder() []string {
+	var order = make([]string, len(query.order))
+	for k, o := range query.order {
+		order[k] = fmt.Sprintf("%d:%d", o.propertyId, o.flags)
+	}
+	return order
+}
+
+func (query *Query) encodePageToken(lastObject interface{}, lastBytes []byte) (string, error) {
+	id, err := query.entity.binding.GetId(lastObject)
+	if err != nil {
+		return "", err
+	}
+
+	// read the property values using the dynamic binding, independent of the (generated) object type
+	values, err := (&dynamicBinding{entity: query.entity}).Load(query.objectBox, lastBytes)
+	if err != nil {
+		return "", err
+	}
+
+	var token = pageToken{
+		EntityId: query.entity.id,
+		Order:    query.pageTokenOrder(),
+		Keys:     make([]interface{}, len(query.order)),
+		Id:       id,
+	}
+
+	for k, order := range query.order {
+		property, err := query.pageProperty(order.propertyId)
+		if err != nil {
+			return "", err
+		}
+
+		if property.typ == C.OBXPropertyType_ByteVector || property.typ == C.OBXPropertyType_StringVector {
+			return "", fmt.Errorf("ordering by property %s is not supported by Page()", property.name)
+		}
+
+		var value = values.(map[string]interface{})[property.name]
+		if value == nil {
+			return "", fmt.Errorf("can't create a page token: object %d has a nil value of the order property %s",
+				id, property.name)
+		} else if f, isFloat32 := value.(float32); isFloat32 {
+			value = float64(f) // widen to float64 before encoding to keep the exact value after decoding
+		}
+		token.Keys[k] = value
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func (query *Query) decodePageToken(text string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %s", err)
+	}
+
+	var token = &pageToken{}
+	var decoder = json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber() // keep large integers intact
+	if err := decoder.Decode(token); err != nil {
+		return nil, fmt.Errorf("invalid page token: %s", err)
+	}
+
+	if token.EntityId != query.entity.id || strings.Join(token.Order, ",") != strings.Join(query.pageTokenOrder(), ",") ||
+		len(token.Keys) != len(query.order) {
+		return nil, errors.New("invalid page token: it was issued for a query with a different entity or order")
+	}
+	return token, nil
+}
+
+// pageCondition creates a condition matching objects following the given token's object in the query order, i.e.
+// `k1 > v1 OR (k1 == v1 AND k2 > v2) OR ... OR (k1 == v1 AND ... AND kn == vn AND id > lastId)`.
+func (query *Query) pageCondition(token *pageToken) (Condition, error) {
+	var alternatives []Condition
+	var equalities []Condition
+
+	for k, order := range query.order {
+		property, err := query.pageProperty(order.propertyId)
+		if err != nil {
+			return nil, err
+		}
+
+		var base = &BaseProperty{Id: property.id, Entity: &Entity{Id: query.entity.id}}
+		var descending = order.flags&C.OBXOrderFlags_DESCENDING != 0
+		var caseSensitive = order.flags&C.OBXOrderFlags_CASE_SENSITIVE != 0
+
+		var equal, following func(qb *QueryBuilder) (ConditionId, error)
+		switch property.typ {
+		case C.OBXPropertyType_String:
+			value, isString := token.Keys[k].(string)
+			if !isString {
+				return nil, errors.New("invalid page token: unexpected value type")
+			}
+			equal = func(qb *QueryBuilder) (ConditionId, error) {
+				return qb.StringEquals(base, value, caseSensitive)
+			}
+			following = func(qb *QueryBuilder) (ConditionId, error) {
+				if descending {
+					return qb.StringLess(base, value, caseSensitive, false)
+				}
+				return qb.StringGreater(base, value, caseSensitive, false)
+			}
+
+		case C.OBXPropertyType_Float, C.OBXPropertyType_Double:
+			value, err := pageTokenFloat(token.Keys[k])
+			if err != nil {
+				return nil, err
+			}
+			equal = func(qb *QueryBuilder) (ConditionId, error) {
+				return qb.DoubleBetween(base, value, value)
+			}
+			following = func(qb *QueryBuilder) (ConditionId, error) {
+				if descending {
+					return qb.DoubleLess(base, value)
+				}
+				return qb.DoubleGreater(base, value)
+			}
+
+		case C.OBXPropertyType_ByteVector, C.OBXPropertyType_StringVector:
+			return nil, fmt.Errorf("ordering by property %s is not supported by Page()", property.name)
+
+		default:
+			value, err := pageTokenInt(token.Keys[k])
+			if err != nil {
+				return nil, err
+			}
+			equal = func(qb *QueryBuilder) (ConditionId, error) {
+				return qb.IntEqual(base, value)
+			}
+			following = func(qb *QueryBuilder) (ConditionId, error) {
+				if descending {
+					return qb.IntLess(base, value)
+				}
+				return qb.IntGreater(base, value)
+			}
+		}
+
+		alternatives = append(alternatives, pageAlternative(equalities, &conditionClosure{apply: following}))
+		equalities = append(equalities, &conditionClosure{apply: equal})
+	}
+
+	if !query.isOrderedById() {
+		var id = int64(token.Id)
+		var idProperty = query.idProperty()
+		var following = &conditionClosure{apply: func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntGreater(idProperty, id)
+		}}
+		alternatives = append(alternatives, pageAlternative(equalities, following))
+	}
+
+	return Any(alternatives...), nil
+}
+
+// pageAlternative combines the equalities with the given condition, without modifying the equalities slice
+func pageAlternative(equalities []Condition, condition Condition) Condition {
+	var conditions = make([]Condition, 0, len(equalities)+1)
+	return All(append(append(conditions, equalities...), condition)...)
+}
+
+func pageTokenInt(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		u, err := strconv.ParseUint(v.String(), 10, 64) // unsigned values are compared as their int64 bit pattern
+		return int64(u), err
+	}
+	return 0, errors.New("invalid page token: unexpected value type")
+}
+
+func pageTokenFloat(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case json.Number:
+		return v.Float64()
+	}
+	return 0, errors.New("invalid page token: unexpected value type")
+}
+
+func (query *Query) pageProperty(propertyId TypeId) (*property, error) {
+	if property := query.entity.propertyById(propertyId); property != nil {
+		return property, nil
+	}
+	return nil, fmt.Errorf("order property %d not found in entity %s", propertyId, query.entity.name)
+}
+
+func (query *Query) idProperty() *BaseProperty {
+	return &BaseProperty{Id: query.entity.idProperty, Entity: &Entity{Id: query.entity.id}}
+}
+
+func (query *Query) isOrderedById() bool {
+	for _, order := range query.order {
+		if order.propertyId == query.entity.idProperty {
+			return true
+		}
+	}
+	return false
+}
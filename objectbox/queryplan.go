@@ -0,0 +1,113 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryPlan is an estimate of whether the conditions of a query can be served by an index, see Query.Plan().
+//
+// Note: the estimate is NOT what the native query engine executes; the engine chooses the actual strategy on its own
+// and doesn't expose it. The estimate is computed in Go from the model (the property index flags) and the kind of each
+// condition: indexes are assumed to be usable for equality conditions, i.e. Equals() (case sensitive for strings) and
+// In(), other conditions are assumed to be evaluated on each object. Use it as a hint, e.g. to spot conditions on
+// properties without an index, and measure actual query times before tuning indexes.
+type QueryPlan struct {
+	// Conditions on properties, in the order they were added; conditions of linked entities come last
+	Conditions []QueryPlanCondition
+
+	// FullScan is true if the objects matching the conditions on the queried entity are estimated not to be found
+	// using indexes, i.e. all the objects need to be visited. That's the case unless at least one of the conditions
+	// combined using All() (AND) or each of the alternatives combined using Any() (OR) can be served by an index.
+	FullScan bool
+}
+
+// QueryPlanCondition describes a single condition on a property, see QueryPlan
+type QueryPlanCondition struct {
+	EntityId   TypeId
+	PropertyId TypeId
+
+	// Property name, as declared in the model
+	Property string
+
+	// Operation is the kind of the condition, e.g. "StringEquals" or "IntGreater"
+	Operation string
+
+	// Indexed is true if the property has an index (or it is the ID property)
+	Indexed bool
+
+	// UsesIndex is true if the index can serve this condition, e.g. it's false for IntGreater even on an indexed property
+	UsesIndex bool
+}
+
+// planIndex tells whether the objects matching a condition can be looked up using an index
+type planIndex int
+
+const (
+	planIndexNone   planIndex = iota // the condition doesn't restrict the objects, e.g. order or a link
+	planIndexUsed                    // the objects can be looked up using an index
+	planIndexUnused                  // the objects must be looked for by visiting all of them
+)
+
+// planIndexOf determines the index usage of a single condition which may have been added as multiple native ones,
+// e.g. a negation replaced by an OR; all of them must be served by an index to avoid visiting all the objects
+func planIndexOf(conditions []QueryPlanCondition) planIndex {
+	if len(conditions) == 0 {
+		return planIndexNone
+	}
+	for _, condition := range conditions {
+		if !condition.UsesIndex {
+			return planIndexUnused
+		}
+	}
+	return planIndexUsed
+}
+
+// combine determines the index usage of a combination of conditions: an OR needs an index for all of them while
+// for an AND, one indexed condition is enough
+func (index planIndex) combine(other planIndex, or bool) planIndex {
+	if index == planIndexNone {
+		return other
+	} else if other == planIndexNone {
+		return index
+	} else if or && index == planIndexUsed && other == planIndexUsed {
+		return planIndexUsed
+	} else if !or && (index == planIndexUsed || other == planIndexUsed) {
+		return planIndexUsed
+	}
+	return planIndexUnused
+}
+
+// String returns a single-line description of the estimate, e.g. for logging
+func (plan *QueryPlan) String() string {
+	var conditions = make([]string, len(plan.Conditions))
+	for k, condition := range plan.Conditions {
+		var access = "scan"
+		if condition.UsesIndex {
+			access = "index"
+		}
+		conditions[k] = fmt.Sprintf("%s(%s):%s", condition.Operation, condition.Property, access)
+	}
+
+	var kind = "estimated index lookup"
+	if plan.FullScan {
+		kind = "estimated full scan"
+	}
+	return fmt.Sprintf("%s [%s]", kind, strings.Join(conditions, ", "))
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"sync"
+)
+
+// QueryPool hands out clones of a prepared query so that it can be executed by concurrent goroutines,
+// each with its own parameters, without building the query again.
+//
+// A typical use, e.g. in a request handler:
+//
+//	query, err := pool.Get()
+//	if err != nil {
+//		return err
+//	}
+//	defer pool.Put(query)
+//	query.SetStringParams(Person_.LastName, name)
+//	people, err := query.Find()
+//
+// A query taken from the pool keeps the parameters (and offset/limit) set by its previous user,
+// so make sure to set all of them before executing the query.
+//
+// The pooled queries are native resources bound to the store: close the pool using Close() when it's no longer
+// needed, at the latest before closing the ObjectBox instance.
+type QueryPool struct {
+	query  *Query
+	mutex  sync.Mutex // protects the fields below; the prototype query may not be cloned concurrently either
+	idle   []*Query
+	closed bool
+}
+
+// NewQueryPool creates a pool of clones of the given query. The query itself is used only as a prototype
+// and must not be used (nor closed) by the caller while the pool is in use; it's closed by QueryPool.Close().
+func NewQueryPool(query *Query) *QueryPool {
+	return &QueryPool{query: query}
+}
+
+// Get returns a query from the pool or a new clone of the prototype query if the pool is empty.
+// Return the query using Put() after you're done with it.
+func (pool *QueryPool) Get() (*Query, error) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if pool.closed {
+		return nil, newIllegalStateError("illegal state; query pool was closed")
+	}
+
+	if count := len(pool.idle); count > 0 {
+		var query = pool.idle[count-1]
+		pool.idle = pool.idle[:count-1]
+		return query, nil
+	}
+	return pool.query.Clone()
+}
+
+// Put returns the query to the pool so that it can be reused by another goroutine.
+// The query must not be used by the caller afterwards. If the pool has been closed in the meantime, the query is closed.
+func (pool *QueryPool) Put(query *Query) {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if pool.closed {
+		_ = query.Close()
+		return
+	}
+	pool.idle = append(pool.idle, query)
+}
+
+// Close closes the prototype query and all the queries currently in the pool. Queries taken from the pool are closed
+// when they're returned using Put(). The pool can't be used anymore afterwards.
+func (pool *QueryPool) Close() error {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+
+	if pool.closed {
+		return nil
+	}
+	pool.closed = true
+
+	var err = pool.query.Close()
+	for _, query := range pool.idle {
+		if err2 := query.Close(); err == nil {
+			err = err2
+		}
+	}
+	pool.idle = nil
+	return err
+}
@@ -0,0 +1,805 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// QueryString creates a query from a textual expression, parsed at runtime using the model's property information.
+// It's useful e.g. to let users define their own filters, without translating them to conditions manually:
+//
+//	box.QueryString("Priority > 3 AND (Text CONTAINS 'bug' OR Tags HAS 'urgent') ORDER BY DateCreated DESC")
+//
+// The expression consists of comparisons `Property operator value`, combined using AND, OR, NOT and parentheses,
+// optionally followed by `ORDER BY Property [ASC|DESC], ...`. Keywords are case insensitive, as are property names.
+// Supported operators (depending on the property type):
+//
+//	=, !=, <, <=, >, >=          e.g. `Priority >= 3`, `Done = true`, `Text != 'foo'`
+//	BETWEEN a AND b              integer and floating point properties, e.g. `Priority BETWEEN 1 AND 3`
+//	IN (a, b, ...)               integer and string properties, e.g. `Status IN (1, 2)`
+//	CONTAINS, STARTSWITH, ENDSWITH   string properties, e.g. `Text STARTSWITH 'Buy'`
+//	HAS                          string vector properties, e.g. `Tags HAS 'urgent'`
+//	IS NULL, IS NOT NULL         any property
+//
+// A comparison or a group of them can be negated by a preceding NOT, e.g. `Status NOT IN (1, 2)` or
+// `NOT (Priority > 3 OR Done = true)`. The database can't negate CONTAINS, STARTSWITH, ENDSWITH and HAS conditions,
+// so a query using them inside a NOT fails.
+// Strings are enclosed in single or double quotes and compared case sensitive.
+// Dates are compared as integers (milliseconds since the Unix epoch).
+//
+// Instead of a literal value, a placeholder `$name` can be used with the =, !=, <, >, CONTAINS, STARTSWITH, ENDSWITH,
+// HAS and IN operators (and <=, >= on strings). The placeholder is mapped to a query alias; set its value before
+// executing the query, e.g. `query.SetInt64Params(objectbox.Alias("minPriority"), 3)` for `Priority > $minPriority`.
+func (box *Box) QueryString(text string) (*Query, error) {
+	conditions, err := parseQueryString(box.entity, text)
+	if err != nil {
+		return nil, err
+	}
+	return box.QueryOrError(conditions...)
+}
+
+type queryTokenKind int
+
+const (
+	queryTokenEnd queryTokenKind = iota
+	queryTokenIdentifier
+	queryTokenNumber
+	queryTokenString
+	queryTokenPlaceholder
+	queryTokenSymbol
+)
+
+// querySymbols lists all the symbols (operators and punctuation) the tokenizer accepts
+var querySymbols = map[string]bool{
+	"=": true, "==": true, "!=": true, "<>": true, "<": true, "<=": true, ">": true, ">=": true,
+	"(": true, ")": true, ",": true,
+}
+
+type queryToken struct {
+	kind   queryTokenKind
+	text   string // identifier, number or symbol text, unquoted string value or placeholder name
+	offset int    // position in the query string, used in error messages
+}
+
+func (token queryToken) String() string {
+	switch token.kind {
+	case queryTokenEnd:
+		return "end of the query"
+	case queryTokenString:
+		return strconv.Quote(token.text)
+	case queryTokenPlaceholder:
+		return "$" + token.text
+	}
+	return "'" + token.text + "'"
+}
+
+// queryParser is a recursive descent parser producing conditions from a query string
+type queryParser struct {
+	entity *entity
+	tokens []queryToken
+	pos    int
+
+	negations int // number of enclosing NOT operators
+}
+
+func parseQueryString(entity *entity, text string) ([]Condition, error) {
+	tokens, err := tokenizeQueryString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var parser = &queryParser{entity: entity, tokens: tokens}
+	conditions, err := parser.parse()
+	if err != nil {
+		return nil, fmt.Errorf("invalid query string: %s", err)
+	}
+	return conditions, nil
+}
+
+func tokenizeQueryString(text string) ([]queryToken, error) {
+	var tokens []queryToken
+	var runes = []rune(text)
+
+	for i := 0; i < len(runes); {
+		var r = runes[i]
+		var start = i
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+
+		case r == '\'' || r == '"':
+			var value strings.Builder
+			for i++; i < len(runes) && runes[i] != r; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				value.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("invalid query string: unterminated string at position %d", start)
+			}
+			i++ // closing quote
+			tokens = append(tokens, queryToken{queryTokenString, value.String(), start})
+
+		case r == '$' || unicode.IsLetter(r) || r == '_':
+			for i++; i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_'); i++ {
+			}
+			if r == '$' {
+				if i == start+1 {
+					return nil, fmt.Errorf("invalid query string: missing placeholder name at position %d", start)
+				}
+				tokens = append(tokens, queryToken{queryTokenPlaceholder, string(runes[start+1 : i]), start})
+			} else {
+				tokens = append(tokens, queryToken{queryTokenIdentifier, string(runes[start:i]), start})
+			}
+
+		case unicode.IsDigit(r) || ((r == '-' || r == '+' || r == '.') && i+1 < len(runes) && (unicode.IsDigit(runes[i+1]) || runes[i+1] == '.')):
+			for i++; i < len(runes) && (unicode.IsDigit(runes[i]) || strings.ContainsRune(".eE", runes[i]) ||
+				((runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E'))); i++ {
+			}
+			tokens = append(tokens, queryToken{queryTokenNumber, string(runes[start:i]), start})
+
+		default:
+			var symbol = string(r)
+			if i+1 < len(runes) {
+				if two := string(runes[i : i+2]); two == "==" || two == "!=" || two == "<>" || two == "<=" || two == ">=" {
+					symbol = two
+				}
+			}
+			if !querySymbols[symbol] {
+				return nil, fmt.Errorf("invalid query string: unexpected character '%c' at position %d", r, start)
+			}
+			i += len(symbol)
+			tokens = append(tokens, queryToken{queryTokenSymbol, symbol, start})
+		}
+	}
+
+	return append(tokens, queryToken{kind: queryTokenEnd, offset: len(runes)}), nil
+}
+
+func (parser *queryParser) peek() queryToken {
+	return parser.tokens[parser.pos]
+}
+
+func (parser *queryParser) next() queryToken {
+	var token = parser.tokens[parser.pos]
+	if token.kind != queryTokenEnd {
+		parser.pos++
+	}
+	return token
+}
+
+// isKeyword checks whether the next token is the given keyword (case insensitive)
+func (parser *queryParser) isKeyword(keyword string) bool {
+	var token = parser.peek()
+	return token.kind == queryTokenIdentifier && strings.EqualFold(token.text, keyword)
+}
+
+// isSymbol checks whether the next token is the given symbol
+func (parser *queryParser) isSymbol(symbol string) bool {
+	var token = parser.peek()
+	return token.kind == queryTokenSymbol && token.text == symbol
+}
+
+func (parser *queryParser) unexpected(expected string) error {
+	var token = parser.peek()
+	return fmt.Errorf("expected %s but found %s at position %d", expected, token, token.offset)
+}
+
+func (parser *queryParser) expectKeyword(keyword string) error {
+	if !parser.isKeyword(keyword) {
+		return parser.unexpected(keyword)
+	}
+	parser.next()
+	return nil
+}
+
+func (parser *queryParser) expectSymbol(symbol string) error {
+	if !parser.isSymbol(symbol) {
+		return parser.unexpected("'" + symbol + "'")
+	}
+	parser.next()
+	return nil
+}
+
+func (parser *queryParser) parse() ([]Condition, error) {
+	var conditions []Condition
+
+	if !parser.isKeyword("ORDER") {
+		condition, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if parser.isKeyword("ORDER") {
+		parser.next()
+		if err := parser.expectKeyword("BY"); err != nil {
+			return nil, err
+		}
+
+		for {
+			property, err := parser.parseProperty()
+			if err != nil {
+				return nil, err
+			}
+
+			var base = BaseProperty{Id: property.id, Entity: &Entity{Id: parser.entity.id}}
+			if parser.isKeyword("DESC") {
+				parser.next()
+				conditions = append(conditions, base.orderDesc())
+			} else {
+				if parser.isKeyword("ASC") {
+					parser.next()
+				}
+				conditions = append(conditions, base.orderAsc())
+			}
+
+			if !parser.isSymbol(",") {
+				break
+			}
+			parser.next()
+		}
+	}
+
+	if parser.peek().kind != queryTokenEnd {
+		return nil, parser.unexpected("end of the query")
+	}
+	return conditions, nil
+}
+
+func (parser *queryParser) parseOr() (Condition, error) {
+	var conditions []Condition
+	for {
+		condition, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if !parser.isKeyword("OR") {
+			break
+		}
+		parser.next()
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+	return Any(conditions...), nil
+}
+
+func (parser *queryParser) parseAnd() (Condition, error) {
+	var conditions []Condition
+	for {
+		condition, err := parser.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+
+		if !parser.isKeyword("AND") {
+			break
+		}
+		parser.next()
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+	return All(conditions...), nil
+}
+
+func (parser *queryParser) parseUnary() (Condition, error) {
+	if parser.isKeyword("NOT") {
+		parser.next()
+		parser.negations++
+		condition, err := parser.parseUnary()
+		parser.negations--
+		if err != nil {
+			return nil, err
+		}
+		return Not(condition), nil
+	}
+
+	if parser.isSymbol("(") {
+		parser.next()
+		condition, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err = parser.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+		return condition, nil
+	}
+
+	return parser.parseComparison()
+}
+
+func (parser *queryParser) parseProperty() (*property, error) {
+	var token = parser.peek()
+	if token.kind != queryTokenIdentifier {
+		return nil, parser.unexpected("a property name")
+	}
+
+	for _, property := range parser.entity.properties {
+		if strings.EqualFold(property.name, token.text) {
+			parser.next()
+			return property, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown property %s at position %d", token.text, token.offset)
+}
+
+func (parser *queryParser) parseComparison() (Condition, error) {
+	var propertyToken = parser.peek()
+	property, err := parser.parseProperty()
+	if err != nil {
+		return nil, err
+	}
+
+	queryProperty, err := property.queryProperty(parser.entity)
+	if err != nil {
+		return nil, err
+	}
+	var comparison = &queryComparison{
+		name:     property.name,
+		property: queryProperty,
+		offset:   propertyToken.offset,
+		negated:  parser.negations > 0,
+	}
+
+	if parser.isKeyword("IS") {
+		parser.next()
+		var negate = parser.isKeyword("NOT")
+		if negate {
+			parser.next()
+		}
+		if err := parser.expectKeyword("NULL"); err != nil {
+			return nil, err
+		}
+		comparison.operator = "IS NULL"
+		if negate {
+			comparison.operator = "IS NOT NULL"
+		}
+		return comparison.condition()
+	}
+
+	var negate = parser.isKeyword("NOT")
+	if negate {
+		parser.next()
+		comparison.negated = true
+	}
+
+	var operatorToken = parser.next()
+	comparison.operator = strings.ToUpper(operatorToken.text)
+	if operatorToken.kind != queryTokenSymbol && operatorToken.kind != queryTokenIdentifier {
+		parser.pos--
+		return nil, parser.unexpected("an operator")
+	}
+
+	switch comparison.operator {
+	case "=", "==", "!=", "<>", "<", "<=", ">", ">=", "CONTAINS", "STARTSWITH", "ENDSWITH", "HAS":
+		value, err := parser.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		comparison.values = []queryToken{value}
+
+	case "BETWEEN":
+		for k := 0; k < 2; k++ {
+			if k == 1 {
+				if err := parser.expectKeyword("AND"); err != nil {
+					return nil, err
+				}
+			}
+			value, err := parser.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			comparison.values = append(comparison.values, value)
+		}
+
+	case "IN":
+		if parser.peek().kind == queryTokenPlaceholder {
+			comparison.values = []queryToken{parser.next()}
+			break
+		}
+
+		if err := parser.expectSymbol("("); err != nil {
+			return nil, err
+		}
+		for {
+			value, err := parser.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			comparison.values = append(comparison.values, value)
+
+			if !parser.isSymbol(",") {
+				break
+			}
+			parser.next()
+		}
+		if err := parser.expectSymbol(")"); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown operator %s at position %d", operatorToken, operatorToken.offset)
+	}
+
+	condition, err := comparison.condition()
+	if err != nil {
+		return nil, err
+	}
+	if negate {
+		condition = Not(condition)
+	}
+	return condition, nil
+}
+
+func (parser *queryParser) parseValue() (queryToken, error) {
+	var token = parser.peek()
+	switch token.kind {
+	case queryTokenNumber, queryTokenString, queryTokenPlaceholder:
+		return parser.next(), nil
+	case queryTokenIdentifier:
+		if strings.EqualFold(token.text, "true") || strings.EqualFold(token.text, "false") {
+			return parser.next(), nil
+		}
+	}
+	return token, parser.unexpected("a value")
+}
+
+// queryComparison creates a condition for a single comparison, based on the property type
+type queryComparison struct {
+	name     string
+	property interface{} // one of the Property* types, see property.queryProperty()
+	offset   int         // position in the query string or -1 if not parsed from a string (see FilterSpec)
+	negated  bool        // whether the condition is (directly or through an enclosing NOT) negated
+	operator string
+	values   []queryToken
+}
+
+func (comparison *queryComparison) errorf(format string, args ...interface{}) error {
+	if comparison.offset < 0 {
+		return fmt.Errorf("%s (%s %s)", fmt.Sprintf(format, args...), comparison.name, comparison.operator)
+	}
+	return fmt.Errorf("%s (%s %s at position %d)", fmt.Sprintf(format, args...), comparison.name, comparison.operator, comparison.offset)
+}
+
+// placeholder returns the placeholder name, if the value is a placeholder. Only a single placeholder per comparison
+// is allowed and only with operators mapping to a single native condition, so that the alias can be used.
+func (comparison *queryComparison) placeholder(singleCondition bool) (string, error) {
+	for _, value := range comparison.values {
+		if value.kind == queryTokenPlaceholder {
+			if !singleCondition || len(comparison.values) > 1 {
+				return "", comparison.errorf("placeholders are not supported with this operator")
+			} else if comparison.negated {
+				return "", comparison.errorf("placeholders are not supported in negated conditions")
+			}
+			return value.text, nil
+		}
+	}
+	return "", nil
+}
+
+func (comparison *queryComparison) condition() (Condition, error) {
+	var base *BaseProperty
+	switch property := comparison.property.(type) {
+	case *PropertyString:
+		return comparison.stringCondition(property.BaseProperty)
+	case *PropertyStringVector:
+		return comparison.stringVectorCondition(property.BaseProperty)
+	case *PropertyFloat32:
+		return comparison.floatCondition(property.BaseProperty, 32)
+	case *PropertyFloat64:
+		return comparison.floatCondition(property.BaseProperty, 64)
+	case *PropertyBool:
+		return comparison.intCondition(property.BaseProperty, 0, false)
+	case *PropertyInt8:
+		return comparison.intCondition(property.BaseProperty, 8, false)
+	case *PropertyUint8:
+		return comparison.intCondition(property.BaseProperty, 8, true)
+	case *PropertyInt16:
+		return comparison.intCondition(property.BaseProperty, 16, false)
+	case *PropertyUint16:
+		return comparison.intCondition(property.BaseProperty, 16, true)
+	case *PropertyInt32:
+		return comparison.intCondition(property.BaseProperty, 32, false)
+	case *PropertyUint32:
+		return comparison.intCondition(property.BaseProperty, 32, true)
+	case *PropertyInt64:
+		return comparison.intCondition(property.BaseProperty, 64, false)
+	case *PropertyUint64:
+		return comparison.intCondition(property.BaseProperty, 64, true)
+	case *PropertyByteVector:
+		base = property.BaseProperty
+	default:
+		return nil, comparison.errorf("unsupported property type %T", property)
+	}
+
+	if condition := comparison.nilCondition(base); condition != nil {
+		return condition, nil
+	}
+	return nil, comparison.errorf("unsupported operator for a byte vector property")
+}
+
+func (comparison *queryComparison) nilCondition(property *BaseProperty) Condition {
+	switch comparison.operator {
+	case "IS NULL":
+		return property.IsNil()
+	case "IS NOT NULL":
+		return property.IsNotNil()
+	}
+	return nil
+}
+
+// closure creates a condition, setting the alias if a placeholder is used
+func (comparison *queryComparison) closure(singleCondition bool, apply func(qb *QueryBuilder) (ConditionId, error)) (Condition, error) {
+	alias, err := comparison.placeholder(singleCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	var condition Condition = &conditionClosure{apply: apply}
+	if alias != "" {
+		condition = condition.Alias(alias)
+	}
+	return condition, nil
+}
+
+func (comparison *queryComparison) stringCondition(property *BaseProperty) (Condition, error) {
+	if condition := comparison.nilCondition(property); condition != nil {
+		return condition, nil
+	}
+
+	var values = make([]string, len(comparison.values))
+	for k, value := range comparison.values {
+		if value.kind == queryTokenString {
+			values[k] = value.text
+		} else if value.kind != queryTokenPlaceholder {
+			return nil, comparison.errorf("expected a string value but found %s", value)
+		}
+	}
+
+	const caseSensitive = true
+	switch comparison.operator {
+	case "=", "==":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringEquals(property, values[0], caseSensitive)
+		})
+	case "!=", "<>":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringNotEquals(property, values[0], caseSensitive)
+		})
+	case "<", "<=":
+		var withEqual = comparison.operator == "<="
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringLess(property, values[0], caseSensitive, withEqual)
+		})
+	case ">", ">=":
+		var withEqual = comparison.operator == ">="
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringGreater(property, values[0], caseSensitive, withEqual)
+		})
+	case "CONTAINS":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringContains(property, values[0], caseSensitive)
+		})
+	case "STARTSWITH":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringHasPrefix(property, values[0], caseSensitive)
+		})
+	case "ENDSWITH":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringHasSuffix(property, values[0], caseSensitive)
+		})
+	case "IN":
+		if comparison.values[0].kind == queryTokenPlaceholder {
+			values = nil // set later using the alias
+		}
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringIn(property, values, caseSensitive)
+		})
+	}
+	return nil, comparison.errorf("unsupported operator for a string property")
+}
+
+func (comparison *queryComparison) stringVectorCondition(property *BaseProperty) (Condition, error) {
+	if condition := comparison.nilCondition(property); condition != nil {
+		return condition, nil
+	}
+
+	switch comparison.operator {
+	case "HAS", "CONTAINS":
+		var value string
+		if token := comparison.values[0]; token.kind == queryTokenString {
+			value = token.text
+		} else if token.kind != queryTokenPlaceholder {
+			return nil, comparison.errorf("expected a string value but found %s", token)
+		}
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.StringVectorContains(property, value, true)
+		})
+	}
+	return nil, comparison.errorf("unsupported operator for a string vector property")
+}
+
+// intCondition creates a condition on an integer property; bits=0 is used for bool properties
+func (comparison *queryComparison) intCondition(property *BaseProperty, bits int, unsigned bool) (Condition, error) {
+	if condition := comparison.nilCondition(property); condition != nil {
+		return condition, nil
+	}
+
+	var values = make([]int64, len(comparison.values))
+	for k, value := range comparison.values {
+		var err error
+		switch {
+		case value.kind == queryTokenPlaceholder:
+		case bits == 0 && value.kind == queryTokenIdentifier:
+			if strings.EqualFold(value.text, "true") {
+				values[k] = 1
+			}
+		case bits > 0 && value.kind == queryTokenNumber && unsigned:
+			var u uint64
+			u, err = strconv.ParseUint(value.text, 10, bits)
+			values[k] = int64(u)
+		case bits > 0 && value.kind == queryTokenNumber:
+			values[k], err = strconv.ParseInt(value.text, 10, bits)
+		default:
+			err = fmt.Errorf("unexpected value type")
+		}
+		if err != nil {
+			return nil, comparison.errorf("invalid value %s: %s", value, err)
+		}
+	}
+
+	switch comparison.operator {
+	case "=", "==":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntEqual(property, values[0])
+		})
+	case "!=", "<>":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntNotEqual(property, values[0])
+		})
+	case "<":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntLess(property, values[0])
+		})
+	case ">":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntGreater(property, values[0])
+		})
+	case "<=":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.IntLess(property, values[0]) },
+				func() (ConditionId, error) { return qb.IntEqual(property, values[0]) })
+		})
+	case ">=":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.IntGreater(property, values[0]) },
+				func() (ConditionId, error) { return qb.IntEqual(property, values[0]) })
+		})
+	case "BETWEEN":
+		if bits == 0 {
+			break
+		}
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.IntBetween(property, values[0], values[1])
+		})
+	case "IN":
+		if bits == 0 {
+			break
+		}
+		if comparison.values[0].kind == queryTokenPlaceholder {
+			values = nil // set later using the alias
+		}
+		if bits == 64 {
+			return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+				return qb.Int64In(property, values)
+			})
+		} else if bits == 32 {
+			var values32 = make([]int32, len(values))
+			for k, value := range values {
+				values32[k] = int32(value)
+			}
+			return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+				return qb.Int32In(property, values32)
+			})
+		}
+
+		// smaller integer types don't support IN natively
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			var fns = make([]func() (ConditionId, error), len(values))
+			for k := range values {
+				var value = values[k]
+				fns[k] = func() (ConditionId, error) { return qb.IntEqual(property, value) }
+			}
+			return qb.anyOf(fns...)
+		})
+	}
+	return nil, comparison.errorf("unsupported operator for an integer property")
+}
+
+func (comparison *queryComparison) floatCondition(property *BaseProperty, bits int) (Condition, error) {
+	if condition := comparison.nilCondition(property); condition != nil {
+		return condition, nil
+	}
+
+	var values = make([]float64, len(comparison.values))
+	for k, value := range comparison.values {
+		if value.kind == queryTokenNumber {
+			var err error
+			if values[k], err = strconv.ParseFloat(value.text, bits); err != nil {
+				return nil, comparison.errorf("invalid value %s: %s", value, err)
+			}
+		} else if value.kind != queryTokenPlaceholder {
+			return nil, comparison.errorf("expected a number but found %s", value)
+		}
+	}
+
+	switch comparison.operator {
+	case "<":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.DoubleLess(property, values[0])
+		})
+	case ">":
+		return comparison.closure(true, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.DoubleGreater(property, values[0])
+		})
+	case "=", "==":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.DoubleBetween(property, values[0], values[0])
+		})
+	case "!=", "<>":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleLess(property, values[0]) },
+				func() (ConditionId, error) { return qb.DoubleGreater(property, values[0]) })
+		})
+	case "<=":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleLess(property, values[0]) },
+				func() (ConditionId, error) { return qb.DoubleBetween(property, values[0], values[0]) })
+		})
+	case ">=":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.anyOf(
+				func() (ConditionId, error) { return qb.DoubleGreater(property, values[0]) },
+				func() (ConditionId, error) { return qb.DoubleBetween(property, values[0], values[0]) })
+		})
+	case "BETWEEN":
+		return comparison.closure(false, func(qb *QueryBuilder) (ConditionId, error) {
+			return qb.DoubleBetween(property, values[0], values[1])
+		})
+	}
+	return nil, comparison.errorf("unsupported operator for a floating point property")
+}
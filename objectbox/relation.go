@@ -23,6 +23,7 @@ import (
 type conditionRelationOneToMany struct {
 	relation   *RelationToOne
 	conditions []Condition
+	backlink   bool    // explicitly requested backlink, otherwise the direction is recognized automatically
 	alias      *string // this is only used to report an error
 }
 
@@ -31,6 +32,9 @@ func (condition *conditionRelationOneToMany) applyTo(qb *QueryBuilder, isRoot bo
 		return 0, fmt.Errorf("using Alias/As(\"%s\") on a OneToMany relation link is not supported", *condition.alias)
 	}
 
+	if condition.backlink {
+		return conditionIdFakeLink, qb.BacklinkOneToMany(condition.relation, condition.conditions)
+	}
 	return conditionIdFakeLink, qb.LinkOneToMany(condition.relation, condition.conditions)
 }
 
@@ -73,6 +77,13 @@ func (relation *RelationToOne) Link(conditions ...Condition) Condition {
 	return &conditionRelationOneToMany{relation: relation, conditions: conditions}
 }
 
+// Backlink creates a connection in the reverse direction, i.e. from the relation target to the source entity,
+// and takes inner conditions to evaluate on the source objects pointing to the queried ones.
+// Use it in a query on the target entity, e.g. to find customers with at least one order over a given amount.
+func (relation *RelationToOne) Backlink(conditions ...Condition) Condition {
+	return &conditionRelationOneToMany{relation: relation, conditions: conditions, backlink: true}
+}
+
 // Equals finds entities with relation target ID equal to the given value
 func (relation RelationToOne) Equals(value uint64) Condition {
 	return &conditionClosure{
@@ -122,6 +133,7 @@ func (relation RelationToOne) NotIn(values ...uint64) Condition {
 type conditionRelationManyToMany struct {
 	relation   *RelationToMany
 	conditions []Condition
+	backlink   bool    // explicitly requested backlink, otherwise the direction is recognized automatically
 	alias      *string // this is only used to report an error
 }
 
@@ -130,6 +142,9 @@ func (condition *conditionRelationManyToMany) applyTo(qb *QueryBuilder, isRoot b
 		return 0, fmt.Errorf("using Alias/As(\"%s\") on a ManyToMany relation link is not supported", *condition.alias)
 	}
 
+	if condition.backlink {
+		return conditionIdFakeLink, qb.BacklinkManyToMany(condition.relation, condition.conditions)
+	}
 	return conditionIdFakeLink, qb.LinkManyToMany(condition.relation, condition.conditions)
 }
 
@@ -161,4 +176,10 @@ func (relation *RelationToMany) Link(conditions ...Condition) Condition {
 	return &conditionRelationManyToMany{relation: relation, conditions: conditions}
 }
 
+// Backlink creates a connection in the reverse direction, i.e. from the relation target to the source entity,
+// and takes inner conditions to evaluate on the source objects related to the queried ones.
+func (relation *RelationToMany) Backlink(conditions ...Condition) Condition {
+	return &conditionRelationManyToMany{relation: relation, conditions: conditions, backlink: true}
+}
+
 // TODO contains() would make sense for many-to-many (slice)
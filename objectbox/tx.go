@@ -0,0 +1,177 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox
+
+/*
+#include <stdlib.h>
+#include "objectbox.h"
+
+// the explicit transaction (see Tx) most recently started on the current thread, used to detect cross-thread usage
+static __thread OBX_txn* currentTxn = NULL;
+
+static OBX_txn* getCurrentTxn() { return currentTxn; }
+static void setCurrentTxn(OBX_txn* txn) { currentTxn = txn; }
+*/
+import "C"
+import (
+	"runtime"
+)
+
+// Tx is an explicitly managed transaction, see ObjectBox.Begin().
+//
+// Native transactions are bound to the thread they were started on. Therefore, Begin() locks the calling goroutine to
+// its current OS thread until the transaction is finished by Commit() or Abort(). Both of these, as well as all
+// operations that should be part of the transaction, must be executed on the same goroutine.
+// Use Tx.Box() or the generated Box.WithTx() to get boxes bound to the transaction; they fail with an error when used
+// after the transaction has finished or from another goroutine (OS thread), instead of silently running in a
+// transaction of their own. Commit() and Abort() fail in that case as well, without finishing the transaction.
+//
+// Usually, RunInReadTx() and RunInWriteTx() are simpler to use and should be preferred.
+type Tx struct {
+	objectBox *ObjectBox
+	cTxn      *C.OBX_txn
+	readOnly  bool
+
+	// the transaction previously started on the same thread (if any), restored as the current one by finish()
+	cPrevTxn *C.OBX_txn
+}
+
+// Begin starts a new transaction, read-only or read-write. You must call Commit() or Abort() to finish it,
+// see Tx for more details. A common way to make sure the transaction is always finished:
+//
+//	tx, err := ob.Begin(false)
+//	if err != nil {
+//		return err
+//	}
+//	defer tx.Abort() // no-op if the transaction has already been committed
+//	... // execute the operations using tx.Box() or the generated box.WithTx(tx)
+//	return tx.Commit()
+func (ob *ObjectBox) Begin(readOnly bool) (*Tx, error) {
+	// NOTE unlocked by Tx.finish()
+	runtime.LockOSThread()
+
+	var tx = &Tx{
+		objectBox: ob,
+		readOnly:  readOnly,
+	}
+
+	if readOnly {
+		tx.cTxn = C.obx_txn_read(ob.store)
+	} else {
+		tx.cTxn = C.obx_txn_write(ob.store)
+	}
+
+	if tx.cTxn == nil {
+		var err = createError()
+		runtime.UnlockOSThread()
+		return nil, err
+	}
+
+	tx.cPrevTxn = C.getCurrentTxn()
+	C.setCurrentTxn(tx.cTxn)
+
+	return tx, nil
+}
+
+// IsReadOnly returns true if the transaction can't be used to change data
+func (tx *Tx) IsReadOnly() bool {
+	return tx.readOnly
+}
+
+// IsActive returns true until the transaction is finished by Commit() or Abort()
+func (tx *Tx) IsActive() bool {
+	return tx.cTxn != nil
+}
+
+// Commit finishes the transaction. For a write transaction, the changes are committed to the database.
+// For a read-only transaction, this just closes it.
+func (tx *Tx) Commit() error {
+	if tx.cTxn == nil {
+		return tx.errorFinished()
+	} else if err := tx.checkThread(); err != nil {
+		return err
+	}
+
+	if tx.readOnly {
+		return tx.finish(C.obx_txn_close(tx.cTxn))
+	}
+
+	// obx_txn_success() closes the transaction as well
+	return tx.finish(C.obx_txn_success(tx.cTxn))
+}
+
+// Abort finishes the transaction, discarding all the changes made by a write transaction.
+// It's a no-op if the transaction has already been finished so it's safe to `defer tx.Abort()` right after Begin().
+func (tx *Tx) Abort() error {
+	if tx.cTxn == nil {
+		return nil
+	} else if err := tx.checkThread(); err != nil {
+		return err
+	}
+
+	if !tx.readOnly {
+		if rc := C.obx_txn_abort(tx.cTxn); rc != 0 {
+			var err = createError()
+			C.obx_txn_close(tx.cTxn)
+			tx.finish(0)
+			return err
+		}
+	}
+
+	return tx.finish(C.obx_txn_close(tx.cTxn))
+}
+
+// Box returns a box bound to this transaction, see Box.WithTx()
+func (tx *Tx) Box(entityId TypeId) *Box {
+	return tx.objectBox.InternalBox(entityId).WithTx(tx)
+}
+
+// finish releases the thread lock acquired by Begin(), regardless of the result of the closing native call
+func (tx *Tx) finish(rc C.obx_err) (err error) {
+	if rc != 0 {
+		err = createError()
+	}
+
+	C.setCurrentTxn(tx.cPrevTxn)
+	tx.cTxn = nil
+	runtime.UnlockOSThread()
+	return err
+}
+
+func (tx *Tx) errorFinished() error {
+	return newIllegalStateError("illegal state; transaction has already been finished")
+}
+
+// check verifies the transaction may be used by boxes bound to it
+func (tx *Tx) check(ob *ObjectBox) error {
+	if tx.cTxn == nil {
+		return tx.errorFinished()
+	} else if tx.objectBox != ob {
+		return newIllegalStateError("illegal state; transaction belongs to a different ObjectBox instance")
+	}
+	return tx.checkThread()
+}
+
+// checkThread verifies the transaction is the current one on this thread, i.e. it's used on the goroutine that started
+// it (which stays locked to the thread) and it's not shadowed by another transaction started later on the same thread
+func (tx *Tx) checkThread() error {
+	if C.getCurrentTxn() != tx.cTxn {
+		return newIllegalStateError("illegal state; transaction used from a goroutine other than the one that started " +
+			"it, or while another transaction started later on the same goroutine is still active")
+	}
+	return nil
+}
@@ -17,8 +17,11 @@
 package objectbox_test
 
 import (
+	"errors"
+	"math"
 	"testing"
 
+	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/test/assert"
 	"github.com/objectbox/objectbox-go/test/model"
 	"github.com/objectbox/objectbox-go/test/model/iot"
@@ -94,6 +97,12 @@ func TestUnique(t *testing.T) {
 	if err == nil {
 		assert.Failf(t, "put() passed instead of an expected unique constraint violation")
 	}
+	// NOTE: type assertion and Is() called directly instead of errors.As()/errors.Is() to support Go < 1.13
+	obxErr, isObxErr := err.(*objectbox.Error)
+	assert.True(t, isObxErr)
+	assert.Eq(t, 10201, obxErr.Code)
+	assert.True(t, obxErr.Is(objectbox.ErrUniqueViolation))
+	assert.True(t, !obxErr.Is(objectbox.ErrNotFound))
 
 	count, err := box.Count()
 	assert.NoErr(t, err)
@@ -362,3 +371,49 @@ func TestBoxGetMany(t *testing.T) {
 	assert.Eq(t, 1, len(objects))
 	assert.True(t, objects[0].Id == 1)
 }
+
+func TestBoxIterate(t *testing.T) {
+	env := iot.NewTestEnv()
+	defer env.Close()
+	box := iot.BoxForEvent(env.ObjectBox)
+
+	iot.PutEvents(env.ObjectBox, 10)
+
+	var collect = func(iterator *iot.EventIterator, limit int) []uint64 {
+		var ids []uint64
+		assert.NoErr(t, iterator.ForEach(func(event *iot.Event) (bool, error) {
+			ids = append(ids, event.Id)
+			return len(ids) < limit, nil
+		}))
+		return ids
+	}
+
+	assert.Eq(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, collect(box.Iterate(), 100))
+	assert.Eq(t, []uint64{1, 2, 3}, collect(box.Iterate(), 3))
+	assert.Eq(t, []uint64{8, 9, 10}, collect(box.Iterate().Seek(8), 100))
+	assert.Eq(t, []uint64{10, 9, 8}, collect(box.Iterate().Reverse(), 3))
+	assert.Eq(t, []uint64{3, 2, 1}, collect(box.Iterate().Reverse().Seek(3), 100))
+
+	// seeking beyond the existing IDs, including the bounds of the ID type
+	assert.Eq(t, []uint64{1, 2}, collect(box.Iterate().Seek(1), 2))
+	assert.Eq(t, 0, len(collect(box.Iterate().Seek(11), 100)))
+	assert.Eq(t, 0, len(collect(box.Iterate().Seek(math.MaxInt64), 100)))
+	assert.Eq(t, 0, len(collect(box.Iterate().Seek(math.MaxUint64), 100)))
+	assert.Eq(t, []uint64{10, 9}, collect(box.Iterate().Reverse().Seek(11), 2))
+	assert.Eq(t, []uint64{10, 9}, collect(box.Iterate().Reverse().Seek(math.MaxInt64), 2))
+	assert.Eq(t, []uint64{10, 9}, collect(box.Iterate().Reverse().Seek(math.MaxUint64), 2))
+
+	// seeking to a removed object starts with the next one
+	assert.NoErr(t, box.RemoveId(5))
+	assert.Eq(t, []uint64{6, 7}, collect(box.Iterate().Seek(5), 2))
+	assert.Eq(t, []uint64{4, 3}, collect(box.Iterate().Seek(5).Reverse(), 2))
+
+	// errors stop the iteration and are passed through
+	var expected = errors.New("expected")
+	var count = 0
+	assert.Eq(t, expected, box.ForEach(func(object interface{}) (bool, error) {
+		count++
+		return true, expected
+	}))
+	assert.Eq(t, 1, count)
+}
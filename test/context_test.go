@@ -0,0 +1,164 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestContextCancelled(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := env.Box.PutManyContext(ctx, []*model.Entity{model.Entity47()})
+	assert.Eq(t, context.Canceled, err)
+
+	_, err = env.Box.GetAllContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(0))
+	_, err = query.FindContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+
+	count, err := query.RemoveContext(ctx)
+	assert.Eq(t, context.Canceled, err)
+	assert.Eq(t, uint64(0), count)
+
+	objects, err := env.Box.GetAll()
+	assert.NoErr(t, err)
+
+	assert.Eq(t, context.Canceled, env.Box.RemoveContext(ctx, objects[0]))
+
+	count, err = env.Box.RemoveManyContext(ctx, objects...)
+	assert.Eq(t, context.Canceled, err)
+	assert.Eq(t, uint64(0), count)
+
+	count, err = env.Box.Box.RemoveIdsContext(ctx, objects[0].Id, objects[1].Id)
+	assert.Eq(t, context.Canceled, err)
+	assert.Eq(t, uint64(0), count)
+
+	assert.Eq(t, context.Canceled, env.Box.Async().AwaitCompletionContext(ctx))
+
+	assert.Eq(t, context.Canceled, env.ObjectBox.RunInReadTxContext(ctx, func() error {
+		assert.Failf(t, "the transaction must not be started")
+		return nil
+	}))
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(10), count)
+}
+
+func TestContextCancelledInTx(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// changes are rolled back if the context is done by the time the callback finishes
+	err := env.ObjectBox.RunInWriteTxContext(ctx, func() error {
+		_, err := env.Box.Put(model.Entity47())
+		cancel()
+		return err
+	})
+	assert.Eq(t, context.Canceled, err)
+
+	count, err := env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+}
+
+func TestContextActive(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var ctx = context.Background()
+
+	ids, err := env.Box.PutManyContext(ctx, []*model.Entity{model.Entity47(), model.Entity47()})
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(ids))
+
+	objects, err := env.Box.GetAllContext(ctx)
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(objects))
+
+	var query = env.Box.Query(model.Entity_.Id.Equals(ids[1]))
+	objects, err = query.FindContext(ctx)
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(objects))
+	assert.Eq(t, ids[1], objects[0].Id)
+
+	count, err := query.RemoveContext(ctx)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	assert.NoErr(t, env.Box.Async().AwaitSubmittedContext(ctx))
+
+	assert.NoErr(t, env.ObjectBox.RunInWriteTxContext(ctx, func() error {
+		_, err := env.Box.Put(model.Entity47())
+		return err
+	}))
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+}
+
+func TestContextRemove(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var ctx = context.Background()
+
+	objects, err := env.Box.GetAll()
+	assert.NoErr(t, err)
+
+	assert.NoErr(t, env.Box.RemoveContext(ctx, objects[0]))
+
+	count, err := env.Box.RemoveManyContext(ctx, objects[1:4]...)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	// not failing for objects that have already been removed
+	count, err = env.Box.Box.RemoveIdsContext(ctx, objects[0].Id, objects[4].Id, objects[5].Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(4), count)
+
+	count, err = env.Box.RemoveManyContext(ctx, objects[6:]...)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(4), count)
+
+	count, err = env.Box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+}
@@ -140,3 +140,53 @@ func TestTimeBinaryConverter(t *testing.T) {
 		assert.Eq(t, date, value)
 	}
 }
+
+func TestConverterConditions(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var box = env.Box
+	var E = model.Entity_
+
+	// entity47() sets the date to 47*i milliseconds after the Unix epoch
+	var date = func(i int64) time.Time {
+		return time.Unix(0, 47*i*int64(time.Millisecond))
+	}
+
+	var count = func(query *model.EntityQuery) uint64 {
+		count, err := query.Count()
+		assert.NoErr(t, err)
+		return count
+	}
+
+	assert.Eq(t, uint64(1), count(box.Query(E.Date.Equals(date(3)))))
+	assert.Eq(t, uint64(9), count(box.Query(E.Date.NotEquals(date(3)))))
+	assert.Eq(t, uint64(5), count(box.Query(E.Date.After(date(5)))))
+	assert.Eq(t, uint64(5), count(box.Query(E.Date.GreaterThan(date(5)))))
+	assert.Eq(t, uint64(2), count(box.Query(E.Date.Before(date(3)))))
+	assert.Eq(t, uint64(3), count(box.Query(E.Date.Between(date(2), date(4)))))
+	assert.Eq(t, uint64(2), count(box.Query(E.Date.In(date(2), date(4), date(47)))))
+	assert.Eq(t, uint64(8), count(box.Query(E.Date.NotIn(date(2), date(4)))))
+	assert.Eq(t, uint64(10), count(box.Query(E.Complex128.Equals(complex(0, 0)))))
+	assert.Eq(t, uint64(0), count(box.Query(E.Complex128.Equals(complex(1, 1)))))
+
+	// the raw (database value) conditions are still available
+	assert.Eq(t, uint64(1), count(box.Query(E.Date.PropertyInt64.Equals(47*3))))
+
+	var query = box.Query(E.Date.Equals(time.Time{}))
+	assert.NoErr(t, E.Date.SetParams(query.Query, date(7)))
+	found, err := query.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{7}, found)
+
+	query = box.Query(E.Date.After(time.Time{}).Alias("from"), E.Date.Before(time.Time{}).Alias("to"))
+	assert.NoErr(t, E.Date.SetAliasParams(query.Query, "from", date(2)))
+	assert.NoErr(t, E.Date.SetAliasParams(query.Query, "to", date(6)))
+	found, err = query.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 4, 5}, found)
+
+	assert.Err(t, E.Date.SetAliasParams(query.Query, "unknown", date(1)))
+}
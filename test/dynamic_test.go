@@ -0,0 +1,146 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestDynamicBoxModelFromJSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "objectbox-test")
+	assert.NoErr(t, err)
+	defer os.RemoveAll(dir)
+
+	ob, err := objectbox.NewBuilder().Directory(dir).ModelFromJSON("model/objectbox-model.json").BuildOrError()
+	assert.NoErr(t, err)
+	defer ob.Close()
+
+	assert.Eq(t, true, len(ob.EntityNames()) > 1)
+	assert.Eq(t, "Entity", ob.EntityNames()[0])
+
+	_, err = ob.DynamicBox("Missing")
+	assert.Err(t, err)
+
+	box, err := ob.DynamicBox("EntityByValue")
+	assert.NoErr(t, err)
+	assert.Eq(t, []string{"Id", "Text"}, box.PropertyNames())
+
+	var object = map[string]interface{}{"Text": "foo"}
+	id, err := box.Put(object)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), id)
+	assert.Eq(t, uint64(1), object["Id"])
+
+	read, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, object, read)
+
+	read, err = box.Get(2)
+	assert.NoErr(t, err)
+	assert.True(t, read == nil)
+
+	// properties not set are nil
+	id, err = box.Put(map[string]interface{}{})
+	assert.NoErr(t, err)
+	read, err = box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, map[string]interface{}{"Id": id, "Text": nil}, read)
+
+	// wrong value type
+	_, err = box.Put(map[string]interface{}{"Text": 1})
+	assert.Err(t, err)
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	all, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(all))
+	assert.Eq(t, "foo", all[0]["Text"])
+}
+
+func TestDynamicBoxGenerated(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var e = model.Entity47()
+	id, err := env.Box.Put(e)
+	assert.NoErr(t, err)
+
+	box, err := env.ObjectBox.DynamicBox("Entity")
+	assert.NoErr(t, err)
+
+	object, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, id, object["Id"])
+	assert.Eq(t, int64(e.Int), object["Int"])
+	assert.Eq(t, e.Int8, object["Int8"])
+	assert.Eq(t, e.Uint16, object["Uint16"])
+	assert.Eq(t, e.Rune, object["Rune"])
+	assert.Eq(t, e.Float32, object["Float32"])
+	assert.Eq(t, e.String, object["String"])
+	assert.Eq(t, e.StringVector, object["StringVector"])
+	assert.Eq(t, e.ByteVector, object["ByteVector"])
+	assert.Eq(t, e.Related.Id, object["Related"])
+	assert.True(t, object["IntPtr"] == nil)
+
+	// update using the dynamic box, changing just a single property
+	before, err := env.Box.Get(id)
+	assert.NoErr(t, err)
+
+	object["String"] = "changed"
+	object["Int8"] = 42 // converted to the property type
+	_, err = box.Put(object)
+	assert.NoErr(t, err)
+
+	after, err := env.Box.Get(id)
+	assert.NoErr(t, err)
+	before.String = "changed"
+	before.Int8 = 42
+	assert.Eq(t, before, after)
+
+	// values must fit into the property type
+	object["Int8"] = 1000
+	_, err = box.Put(object)
+	assert.Err(t, err)
+
+	// queries through the dynamic box return maps as well
+	found, err := box.Query(model.Entity_.String.Equals("changed", true)).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(found.([]map[string]interface{})))
+
+	// query-building properties are available by name
+	property, err := box.Property("String")
+	assert.NoErr(t, err)
+	found, err = box.Query(property.(*objectbox.PropertyString).Equals("changed", true)).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(found.([]map[string]interface{})))
+
+	property, err = box.Property("Id")
+	assert.NoErr(t, err)
+	assert.Eq(t, model.Entity_.Id.Id, property.(*objectbox.PropertyUint64).Id)
+
+	_, err = box.Property("Missing")
+	assert.Err(t, err)
+}
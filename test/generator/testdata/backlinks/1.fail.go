@@ -0,0 +1,8 @@
+package object
+
+// ERROR = can't prepare bindings for testdata/backlinks/1.fail.go: backlink field must be a slice of source entity objects, got string on property Orders found in InvalidType
+
+type InvalidType struct {
+	Id     uint64
+	Orders string `objectbox:"backlink:Customer"`
+}
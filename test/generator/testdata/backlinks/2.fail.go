@@ -0,0 +1,13 @@
+package object
+
+// ERROR = can't merge binding model information: InvalidSource.Name is not a relation to InvalidTarget, referenced by backlink InvalidTarget.Sources
+
+type InvalidSource struct {
+	Id   uint64
+	Name string
+}
+
+type InvalidTarget struct {
+	Id      uint64
+	Sources []*InvalidSource `objectbox:"backlink:Name"`
+}
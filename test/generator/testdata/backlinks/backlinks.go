@@ -0,0 +1,28 @@
+package object
+
+type Customer struct {
+	Id     uint64
+	Name   string
+	Tags   []*Tag   `objectbox:"lazy"`
+	Orders []*Order `objectbox:"backlink:Customer"`
+}
+
+type Order struct {
+	Id       uint64
+	Customer *Customer `objectbox:"link"`
+}
+
+type Tag struct {
+	Id        uint64
+	Customers []*Customer `objectbox:"backlink:Tags"`
+}
+
+type Seller struct {
+	Id    uint64
+	Sales []Sale `objectbox:"backlink:Seller"`
+}
+
+type Sale struct {
+	Id     uint64
+	Seller *Seller `objectbox:"link"`
+}
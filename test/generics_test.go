@@ -0,0 +1,162 @@
+//go:build go1.18
+// +build go1.18
+
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"testing"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func TestBoxOf(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = objectbox.BoxFor[model.Entity](env.ObjectBox)
+
+	id, err := box.Put(model.Entity47())
+	assert.NoErr(t, err)
+
+	object, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "Val-1", object.String)
+
+	object, err = box.Get(id + 1)
+	assert.NoErr(t, err)
+	assert.True(t, object == nil)
+
+	ids, err := box.PutMany([]*model.Entity{model.Entity47(), model.Entity47()})
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(ids))
+
+	objects, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 3, len(objects))
+
+	objects, err = box.GetMany(ids...)
+	assert.NoErr(t, err)
+	assert.Eq(t, ids[1], objects[1].Id)
+
+	var count int
+	assert.NoErr(t, box.ForEach(func(object *model.Entity) (bool, error) {
+		count++
+		return true, nil
+	}))
+	assert.Eq(t, 3, count)
+
+	objects, err = box.Query(model.Entity_.Id.GreaterThan(id)).Limit(1).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(objects))
+	assert.Eq(t, ids[0], objects[0].Id)
+
+	removed, err := box.RemoveMany(objects...)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), removed)
+
+	id, err = box.Async().Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.NoErr(t, box.Async().AwaitSubmitted())
+	contains, err := box.Contains(id)
+	assert.NoErr(t, err)
+	assert.True(t, contains)
+
+	// the same entity is available through the generated and the generic box
+	assert.Eq(t, model.BoxForEntity(env.ObjectBox).Box, box.Box)
+}
+
+func TestBoxOfUnknownType(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	_, err := objectbox.BoxForOrError[struct{ Id uint64 }](env.ObjectBox)
+	assert.Err(t, err)
+
+	_, err = objectbox.NewAsyncBoxOf[model.TestEnv](env.ObjectBox, 1000)
+	assert.Err(t, err)
+
+	async, err := objectbox.NewAsyncBoxOf[model.TestEntityRelated](env.ObjectBox, 1000)
+	assert.NoErr(t, err)
+	assert.NoErr(t, async.Close())
+}
+
+func TestBoxOfByValue(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	// the binding of EntityByValue reads []EntityByValue slices, the generic box must still return pointers
+	var box = objectbox.BoxFor[model.EntityByValue](env.ObjectBox)
+
+	id, err := box.Put(&model.EntityByValue{Text: "first"})
+	assert.NoErr(t, err)
+
+	ids, err := box.PutMany([]*model.EntityByValue{{Text: "second"}, {Text: "third"}})
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(ids))
+
+	object, err := box.Get(id)
+	assert.NoErr(t, err)
+	assert.Eq(t, "first", object.Text)
+
+	objects, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 3, len(objects))
+	assert.Eq(t, "third", objects[2].Text)
+
+	// by-value bindings can't return nil for missing objects, a zero value is returned instead
+	objects, err = box.GetMany(ids[1], ids[1]+1)
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(objects))
+	assert.Eq(t, "third", objects[0].Text)
+	assert.Eq(t, uint64(0), objects[1].Id)
+
+	var count int
+	assert.NoErr(t, box.ForEach(func(object *model.EntityByValue) (bool, error) {
+		count++
+		return true, nil
+	}))
+	assert.Eq(t, 3, count)
+
+	var query = box.Query(model.EntityByValue_.Id.GreaterThan(id))
+	objects, err = query.Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(objects))
+	assert.Eq(t, ids[0], objects[0].Id)
+
+	object, err = query.FindFirst()
+	assert.NoErr(t, err)
+	assert.Eq(t, "second", object.Text)
+
+	objects, err = query.Filter(func(object *model.EntityByValue) bool {
+		return object.Text == "third"
+	}).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(objects))
+	assert.Eq(t, ids[1], objects[0].Id)
+
+	removed, err := box.RemoveMany(objects...)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), removed)
+
+	total, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), total)
+}
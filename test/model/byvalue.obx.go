@@ -4,6 +4,7 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
@@ -144,6 +145,13 @@ func BoxForEntityByValue(ob *objectbox.ObjectBox) *EntityByValueBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *EntityByValueBox) WithTx(tx *objectbox.Tx) *EntityByValueBox {
+	return &EntityByValueBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the EntityByValue.Id property on the passed object will be assigned the new ID as well.
@@ -184,6 +192,12 @@ func (box *EntityByValueBox) PutMany(objects []EntityByValue) ([]uint64, error)
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *EntityByValueBox) PutManyContext(ctx context.Context, objects []EntityByValue) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -225,11 +239,31 @@ func (box *EntityByValueBox) GetAll() ([]EntityByValue, error) {
 	return objects.([]EntityByValue), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *EntityByValueBox) GetAllContext(ctx context.Context) ([]EntityByValue, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]EntityByValue), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *EntityByValueBox) Iterate() *EntityByValueIterator {
+	return &EntityByValueIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *EntityByValueBox) Remove(object *EntityByValue) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *EntityByValueBox) RemoveContext(ctx context.Context, object *EntityByValue) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -243,6 +277,16 @@ func (box *EntityByValueBox) RemoveMany(objects ...*EntityByValue) (uint64, erro
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *EntityByValueBox) RemoveManyContext(ctx context.Context, objects ...*EntityByValue) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the EntityByValue_ struct to create conditions.
 // Keep the *EntityByValueQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -325,6 +369,30 @@ func (asyncBox *EntityByValueAsyncBox) Remove(object *EntityByValue) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// EntityByValueIterator streams stored objects one by one, see EntityByValueBox.Iterate()
+type EntityByValueIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *EntityByValueIterator) Seek(id uint64) *EntityByValueIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *EntityByValueIterator) Reverse() *EntityByValueIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *EntityByValueIterator) ForEach(fn func(*EntityByValue) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*EntityByValue))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all EntityByValue which Id is either 42 or 47:
@@ -342,6 +410,58 @@ func (query *EntityByValueQuery) Find() ([]EntityByValue, error) {
 	return objects.([]EntityByValue), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *EntityByValueQuery) FindContext(ctx context.Context) ([]EntityByValue, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]EntityByValue), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *EntityByValueQuery) FindFirst() (*EntityByValue, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*EntityByValue), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *EntityByValueQuery) FindUnique() (*EntityByValue, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*EntityByValue), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *EntityByValueQuery) Page(size uint64, afterToken string) ([]EntityByValue, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]EntityByValue), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *EntityByValueQuery) Filter(fn func(*EntityByValue) bool) *EntityByValueQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*EntityByValue))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityByValueQuery) Offset(offset uint64) *EntityByValueQuery {
 	query.Query.Offset(offset)
@@ -353,3 +473,23 @@ func (query *EntityByValueQuery) Limit(limit uint64) *EntityByValueQuery {
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *EntityByValueQuery) ForEach(fn func(*EntityByValue) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*EntityByValue))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *EntityByValueQuery) Subscribe(fn func([]EntityByValue, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]EntityByValue), nil)
+		}
+	})
+}
@@ -97,4 +97,8 @@ type TestEntityRelated struct {
 	// have another level of relations
 	Next      *EntityByValue `objectbox:"link"`
 	NextSlice []EntityByValue
+
+	// entities pointing to this one (read-only)
+	BacklinkPtr   []*Entity `objectbox:"backlink:RelatedPtr"`
+	BacklinkSlice []*Entity `objectbox:"backlink:RelatedPtrSlice"`
 }
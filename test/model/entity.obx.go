@@ -4,10 +4,12 @@
 package model
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/objectbox/fbutils"
+	"time"
 )
 
 type entity_EntityInfo struct {
@@ -43,8 +45,8 @@ var Entity_ = struct {
 	Rune            *objectbox.PropertyRune
 	Float32         *objectbox.PropertyFloat32
 	Float64         *objectbox.PropertyFloat64
-	Date            *objectbox.PropertyInt64
-	Complex128      *objectbox.PropertyByteVector
+	Date            *EntityDateProperty
+	Complex128      *EntityComplex128Property
 	Related         *objectbox.RelationToOne
 	RelatedPtr      *objectbox.RelationToOne
 	RelatedPtr2     *objectbox.RelationToOne
@@ -183,16 +185,20 @@ var Entity_ = struct {
 			Entity: &EntityBinding.Entity,
 		},
 	},
-	Date: &objectbox.PropertyInt64{
-		BaseProperty: &objectbox.BaseProperty{
-			Id:     19,
-			Entity: &EntityBinding.Entity,
+	Date: &EntityDateProperty{
+		PropertyInt64: &objectbox.PropertyInt64{
+			BaseProperty: &objectbox.BaseProperty{
+				Id:     19,
+				Entity: &EntityBinding.Entity,
+			},
 		},
 	},
-	Complex128: &objectbox.PropertyByteVector{
-		BaseProperty: &objectbox.BaseProperty{
-			Id:     20,
-			Entity: &EntityBinding.Entity,
+	Complex128: &EntityComplex128Property{
+		PropertyByteVector: &objectbox.PropertyByteVector{
+			BaseProperty: &objectbox.BaseProperty{
+				Id:     20,
+				Entity: &EntityBinding.Entity,
+			},
 		},
 	},
 	Related: &objectbox.RelationToOne{
@@ -336,6 +342,194 @@ var Entity_ = struct {
 	},
 }
 
+// EntityDateProperty is a query helper for Entity.Date accepting time.Time values
+// in conditions and query parameters. The values are converted using objectbox.TimeInt64ConvertToDatabaseValue().
+type EntityDateProperty struct {
+	*objectbox.PropertyInt64
+}
+
+func (property *EntityDateProperty) databaseValue(value time.Time) (int64, error) {
+	dbValue, err := objectbox.TimeInt64ConvertToDatabaseValue(value)
+	if err != nil {
+		return dbValue, errors.New("converter objectbox.TimeInt64ConvertToDatabaseValue() failed on Entity.Date: " + err.Error())
+	}
+	return dbValue, nil
+}
+
+// Equals is like objectbox.PropertyInt64.Equals() but accepts a time.Time value
+func (property *EntityDateProperty) Equals(value time.Time) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyInt64.Equals(dbValue), err)
+}
+
+// NotEquals is like objectbox.PropertyInt64.NotEquals() but accepts a time.Time value
+func (property *EntityDateProperty) NotEquals(value time.Time) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyInt64.NotEquals(dbValue), err)
+}
+
+// GreaterThan is like objectbox.PropertyInt64.GreaterThan() but accepts a time.Time value
+func (property *EntityDateProperty) GreaterThan(value time.Time) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyInt64.GreaterThan(dbValue), err)
+}
+
+// LessThan is like objectbox.PropertyInt64.LessThan() but accepts a time.Time value
+func (property *EntityDateProperty) LessThan(value time.Time) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyInt64.LessThan(dbValue), err)
+}
+
+// Between is like objectbox.PropertyInt64.Between() but accepts time.Time values
+func (property *EntityDateProperty) Between(a, b time.Time) objectbox.Condition {
+	dbA, err := property.databaseValue(a)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	dbB, err := property.databaseValue(b)
+	return objectbox.ConditionOrError(property.PropertyInt64.Between(dbA, dbB), err)
+}
+
+// In is like objectbox.PropertyInt64.In() but accepts time.Time values
+func (property *EntityDateProperty) In(values ...time.Time) objectbox.Condition {
+	dbValues, err := property.databaseValues(values)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	return property.PropertyInt64.In(dbValues...)
+}
+
+// NotIn is like objectbox.PropertyInt64.NotIn() but accepts time.Time values
+func (property *EntityDateProperty) NotIn(values ...time.Time) objectbox.Condition {
+	dbValues, err := property.databaseValues(values)
+	if err != nil {
+		return objectbox.ConditionOrError(nil, err)
+	}
+	return property.PropertyInt64.NotIn(dbValues...)
+}
+
+func (property *EntityDateProperty) databaseValues(values []time.Time) ([]int64, error) {
+	var dbValues = make([]int64, len(values))
+	for k, value := range values {
+		dbValue, err := property.databaseValue(value)
+		if err != nil {
+			return nil, err
+		}
+		dbValues[k] = dbValue
+	}
+	return dbValues, nil
+}
+
+// After finds entities with the stored time after the given one, see GreaterThan()
+func (property *EntityDateProperty) After(value time.Time) objectbox.Condition {
+	return property.GreaterThan(value)
+}
+
+// Before finds entities with the stored time before the given one, see LessThan()
+func (property *EntityDateProperty) Before(value time.Time) objectbox.Condition {
+	return property.LessThan(value)
+}
+
+// SetParams changes the parameter values of the condition on this property in the given query,
+// converting them using objectbox.TimeInt64ConvertToDatabaseValue()
+func (property *EntityDateProperty) SetParams(query *objectbox.Query, values ...time.Time) error {
+	return property.setParams(query, "", values)
+}
+
+// SetAliasParams changes the parameter values of the condition with the given alias in the given query,
+// converting them using objectbox.TimeInt64ConvertToDatabaseValue()
+func (property *EntityDateProperty) SetAliasParams(query *objectbox.Query, alias string, values ...time.Time) error {
+	return property.setParams(query, alias, values)
+}
+
+func (property *EntityDateProperty) setParams(query *objectbox.Query, alias string, values []time.Time) error {
+	var dbValues = make([]int64, len(values))
+	for k, value := range values {
+		dbValue, err := property.databaseValue(value)
+		if err != nil {
+			return err
+		}
+		dbValues[k] = int64(dbValue)
+	}
+
+	if alias == "" {
+		return query.SetInt64Params(property, dbValues...)
+	}
+	return query.SetInt64Params(objectbox.Alias(alias), dbValues...)
+}
+
+// EntityComplex128Property is a query helper for Entity.Complex128 accepting complex128 values
+// in conditions and query parameters. The values are converted using complex128BytesToDatabaseValue().
+type EntityComplex128Property struct {
+	*objectbox.PropertyByteVector
+}
+
+func (property *EntityComplex128Property) databaseValue(value complex128) ([]byte, error) {
+	dbValue, err := complex128BytesToDatabaseValue(value)
+	if err != nil {
+		return dbValue, errors.New("converter complex128BytesToDatabaseValue() failed on Entity.Complex128: " + err.Error())
+	}
+	return dbValue, nil
+}
+
+// Equals is like objectbox.PropertyByteVector.Equals() but accepts a complex128 value
+func (property *EntityComplex128Property) Equals(value complex128) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyByteVector.Equals(dbValue), err)
+}
+
+// GreaterThan is like objectbox.PropertyByteVector.GreaterThan() but accepts a complex128 value
+func (property *EntityComplex128Property) GreaterThan(value complex128) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyByteVector.GreaterThan(dbValue), err)
+}
+
+// GreaterOrEqual is like objectbox.PropertyByteVector.GreaterOrEqual() but accepts a complex128 value
+func (property *EntityComplex128Property) GreaterOrEqual(value complex128) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyByteVector.GreaterOrEqual(dbValue), err)
+}
+
+// LessThan is like objectbox.PropertyByteVector.LessThan() but accepts a complex128 value
+func (property *EntityComplex128Property) LessThan(value complex128) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyByteVector.LessThan(dbValue), err)
+}
+
+// LessOrEqual is like objectbox.PropertyByteVector.LessOrEqual() but accepts a complex128 value
+func (property *EntityComplex128Property) LessOrEqual(value complex128) objectbox.Condition {
+	dbValue, err := property.databaseValue(value)
+	return objectbox.ConditionOrError(property.PropertyByteVector.LessOrEqual(dbValue), err)
+}
+
+// SetParams changes the parameter values of the condition on this property in the given query,
+// converting them using complex128BytesToDatabaseValue()
+func (property *EntityComplex128Property) SetParams(query *objectbox.Query, values ...complex128) error {
+	return property.setParams(query, "", values)
+}
+
+// SetAliasParams changes the parameter values of the condition with the given alias in the given query,
+// converting them using complex128BytesToDatabaseValue()
+func (property *EntityComplex128Property) SetAliasParams(query *objectbox.Query, alias string, values ...complex128) error {
+	return property.setParams(query, alias, values)
+}
+
+func (property *EntityComplex128Property) setParams(query *objectbox.Query, alias string, values []complex128) error {
+	var dbValues = make([][]byte, len(values))
+	for k, value := range values {
+		dbValue, err := property.databaseValue(value)
+		if err != nil {
+			return err
+		}
+		dbValues[k] = dbValue
+	}
+
+	if alias == "" {
+		return query.SetBytesParams(property, dbValues...)
+	}
+	return query.SetBytesParams(objectbox.Alias(alias), dbValues...)
+}
+
 // GeneratorVersion is called by ObjectBox to verify the compatibility of the generator used to generate this code
 func (entity_EntityInfo) GeneratorVersion() int {
 	return 5
@@ -755,6 +949,13 @@ func BoxForEntity(ob *objectbox.ObjectBox) *EntityBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *EntityBox) WithTx(tx *objectbox.Tx) *EntityBox {
+	return &EntityBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the Entity.Id property on the passed object will be assigned the new ID as well.
@@ -795,6 +996,12 @@ func (box *EntityBox) PutMany(objects []*Entity) ([]uint64, error) {
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *EntityBox) PutManyContext(ctx context.Context, objects []*Entity) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -836,6 +1043,21 @@ func (box *EntityBox) GetAll() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *EntityBox) GetAllContext(ctx context.Context) ([]*Entity, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Entity), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *EntityBox) Iterate() *EntityIterator {
+	return &EntityIterator{box.Box.Iterate()}
+}
+
 // FetchRelatedPtrSlice reads target objects for relation Entity::RelatedPtrSlice.
 // It will "GetManyExisting()" all related TestEntityRelated objects for each source object
 // and set sourceObject.RelatedPtrSlice to the slice of related objects, as currently stored in DB.
@@ -869,6 +1091,11 @@ func (box *EntityBox) Remove(object *Entity) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *EntityBox) RemoveContext(ctx context.Context, object *Entity) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -882,6 +1109,16 @@ func (box *EntityBox) RemoveMany(objects ...*Entity) (uint64, error) {
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *EntityBox) RemoveManyContext(ctx context.Context, objects ...*Entity) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the Entity_ struct to create conditions.
 // Keep the *EntityQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -964,6 +1201,30 @@ func (asyncBox *EntityAsyncBox) Remove(object *Entity) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// EntityIterator streams stored objects one by one, see EntityBox.Iterate()
+type EntityIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *EntityIterator) Seek(id uint64) *EntityIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *EntityIterator) Reverse() *EntityIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *EntityIterator) ForEach(fn func(*Entity) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all Entity which Id is either 42 or 47:
@@ -981,6 +1242,58 @@ func (query *EntityQuery) Find() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *EntityQuery) FindContext(ctx context.Context) ([]*Entity, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Entity), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *EntityQuery) FindFirst() (*Entity, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Entity), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *EntityQuery) FindUnique() (*Entity, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Entity), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *EntityQuery) Page(size uint64, afterToken string) ([]*Entity, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*Entity), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *EntityQuery) Filter(fn func(*Entity) bool) *EntityQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*Entity))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityQuery) Offset(offset uint64) *EntityQuery {
 	query.Query.Offset(offset)
@@ -993,6 +1306,26 @@ func (query *EntityQuery) Limit(limit uint64) *EntityQuery {
 	return query
 }
 
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *EntityQuery) ForEach(fn func(*Entity) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*Entity))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *EntityQuery) Subscribe(fn func([]*Entity, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*Entity), nil)
+		}
+	})
+}
+
 type testStringIdEntity_EntityInfo struct {
 	objectbox.Entity
 	Uid uint64
@@ -1102,6 +1435,13 @@ func BoxForTestStringIdEntity(ob *objectbox.ObjectBox) *TestStringIdEntityBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *TestStringIdEntityBox) WithTx(tx *objectbox.Tx) *TestStringIdEntityBox {
+	return &TestStringIdEntityBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the TestStringIdEntity.Id property on the passed object will be assigned the new ID as well.
@@ -1142,6 +1482,12 @@ func (box *TestStringIdEntityBox) PutMany(objects []*TestStringIdEntity) ([]uint
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *TestStringIdEntityBox) PutManyContext(ctx context.Context, objects []*TestStringIdEntity) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -1183,11 +1529,31 @@ func (box *TestStringIdEntityBox) GetAll() ([]*TestStringIdEntity, error) {
 	return objects.([]*TestStringIdEntity), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *TestStringIdEntityBox) GetAllContext(ctx context.Context) ([]*TestStringIdEntity, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestStringIdEntity), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *TestStringIdEntityBox) Iterate() *TestStringIdEntityIterator {
+	return &TestStringIdEntityIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *TestStringIdEntityBox) Remove(object *TestStringIdEntity) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *TestStringIdEntityBox) RemoveContext(ctx context.Context, object *TestStringIdEntity) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -1205,6 +1571,20 @@ func (box *TestStringIdEntityBox) RemoveMany(objects ...*TestStringIdEntity) (ui
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *TestStringIdEntityBox) RemoveManyContext(ctx context.Context, objects ...*TestStringIdEntity) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	var err error
+	for k, object := range objects {
+		ids[k], err = objectbox.StringIdConvertToDatabaseValue(object.Id)
+		if err != nil {
+			return 0, errors.New("converter objectbox.StringIdConvertToDatabaseValue() failed on TestStringIdEntity.Id: " + err.Error())
+		}
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the TestStringIdEntity_ struct to create conditions.
 // Keep the *TestStringIdEntityQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -1287,6 +1667,30 @@ func (asyncBox *TestStringIdEntityAsyncBox) Remove(object *TestStringIdEntity) e
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// TestStringIdEntityIterator streams stored objects one by one, see TestStringIdEntityBox.Iterate()
+type TestStringIdEntityIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *TestStringIdEntityIterator) Seek(id uint64) *TestStringIdEntityIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *TestStringIdEntityIterator) Reverse() *TestStringIdEntityIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *TestStringIdEntityIterator) ForEach(fn func(*TestStringIdEntity) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestStringIdEntity))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all TestStringIdEntity which Id is either 42 or 47:
@@ -1304,6 +1708,58 @@ func (query *TestStringIdEntityQuery) Find() ([]*TestStringIdEntity, error) {
 	return objects.([]*TestStringIdEntity), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *TestStringIdEntityQuery) FindContext(ctx context.Context) ([]*TestStringIdEntity, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestStringIdEntity), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *TestStringIdEntityQuery) FindFirst() (*TestStringIdEntity, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestStringIdEntity), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *TestStringIdEntityQuery) FindUnique() (*TestStringIdEntity, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestStringIdEntity), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *TestStringIdEntityQuery) Page(size uint64, afterToken string) ([]*TestStringIdEntity, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*TestStringIdEntity), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *TestStringIdEntityQuery) Filter(fn func(*TestStringIdEntity) bool) *TestStringIdEntityQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*TestStringIdEntity))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestStringIdEntityQuery) Offset(offset uint64) *TestStringIdEntityQuery {
 	query.Query.Offset(offset)
@@ -1316,6 +1772,26 @@ func (query *TestStringIdEntityQuery) Limit(limit uint64) *TestStringIdEntityQue
 	return query
 }
 
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *TestStringIdEntityQuery) ForEach(fn func(*TestStringIdEntity) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*TestStringIdEntity))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *TestStringIdEntityQuery) Subscribe(fn func([]*TestStringIdEntity, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*TestStringIdEntity), nil)
+		}
+	})
+}
+
 type testEntityInline_EntityInfo struct {
 	objectbox.Entity
 	Uid uint64
@@ -1448,6 +1924,13 @@ func BoxForTestEntityInline(ob *objectbox.ObjectBox) *TestEntityInlineBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *TestEntityInlineBox) WithTx(tx *objectbox.Tx) *TestEntityInlineBox {
+	return &TestEntityInlineBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the TestEntityInline.Id property on the passed object will be assigned the new ID as well.
@@ -1488,6 +1971,12 @@ func (box *TestEntityInlineBox) PutMany(objects []*TestEntityInline) ([]uint64,
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *TestEntityInlineBox) PutManyContext(ctx context.Context, objects []*TestEntityInline) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -1529,11 +2018,31 @@ func (box *TestEntityInlineBox) GetAll() ([]*TestEntityInline, error) {
 	return objects.([]*TestEntityInline), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *TestEntityInlineBox) GetAllContext(ctx context.Context) ([]*TestEntityInline, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestEntityInline), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *TestEntityInlineBox) Iterate() *TestEntityInlineIterator {
+	return &TestEntityInlineIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *TestEntityInlineBox) Remove(object *TestEntityInline) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *TestEntityInlineBox) RemoveContext(ctx context.Context, object *TestEntityInline) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -1547,6 +2056,16 @@ func (box *TestEntityInlineBox) RemoveMany(objects ...*TestEntityInline) (uint64
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *TestEntityInlineBox) RemoveManyContext(ctx context.Context, objects ...*TestEntityInline) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the TestEntityInline_ struct to create conditions.
 // Keep the *TestEntityInlineQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -1629,6 +2148,30 @@ func (asyncBox *TestEntityInlineAsyncBox) Remove(object *TestEntityInline) error
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// TestEntityInlineIterator streams stored objects one by one, see TestEntityInlineBox.Iterate()
+type TestEntityInlineIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *TestEntityInlineIterator) Seek(id uint64) *TestEntityInlineIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *TestEntityInlineIterator) Reverse() *TestEntityInlineIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *TestEntityInlineIterator) ForEach(fn func(*TestEntityInline) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityInline))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all TestEntityInline which Id is either 42 or 47:
@@ -1646,6 +2189,58 @@ func (query *TestEntityInlineQuery) Find() ([]*TestEntityInline, error) {
 	return objects.([]*TestEntityInline), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *TestEntityInlineQuery) FindContext(ctx context.Context) ([]*TestEntityInline, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestEntityInline), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *TestEntityInlineQuery) FindFirst() (*TestEntityInline, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestEntityInline), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *TestEntityInlineQuery) FindUnique() (*TestEntityInline, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestEntityInline), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *TestEntityInlineQuery) Page(size uint64, afterToken string) ([]*TestEntityInline, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*TestEntityInline), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *TestEntityInlineQuery) Filter(fn func(*TestEntityInline) bool) *TestEntityInlineQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*TestEntityInline))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestEntityInlineQuery) Offset(offset uint64) *TestEntityInlineQuery {
 	query.Query.Offset(offset)
@@ -1658,6 +2253,26 @@ func (query *TestEntityInlineQuery) Limit(limit uint64) *TestEntityInlineQuery {
 	return query
 }
 
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *TestEntityInlineQuery) ForEach(fn func(*TestEntityInline) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*TestEntityInline))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *TestEntityInlineQuery) Subscribe(fn func([]*TestEntityInline, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*TestEntityInline), nil)
+		}
+	})
+}
+
 type testEntityRelated_EntityInfo struct {
 	objectbox.Entity
 	Uid uint64
@@ -1672,10 +2287,12 @@ var TestEntityRelatedBinding = testEntityRelated_EntityInfo{
 
 // TestEntityRelated_ contains type-based Property helpers to facilitate some common operations such as Queries.
 var TestEntityRelated_ = struct {
-	Id        *objectbox.PropertyUint64
-	Name      *objectbox.PropertyString
-	Next      *objectbox.RelationToOne
-	NextSlice *objectbox.RelationToMany
+	Id            *objectbox.PropertyUint64
+	Name          *objectbox.PropertyString
+	Next          *objectbox.RelationToOne
+	NextSlice     *objectbox.RelationToMany
+	BacklinkPtr   *objectbox.RelationToOne
+	BacklinkSlice *objectbox.RelationToMany
 }{
 	Id: &objectbox.PropertyUint64{
 		BaseProperty: &objectbox.BaseProperty{
@@ -1701,6 +2318,18 @@ var TestEntityRelated_ = struct {
 		Source: &TestEntityRelatedBinding.Entity,
 		Target: &EntityByValueBinding.Entity,
 	},
+	BacklinkPtr: &objectbox.RelationToOne{
+		Property: &objectbox.BaseProperty{
+			Id:     23,
+			Entity: &EntityBinding.Entity,
+		},
+		Target: &TestEntityRelatedBinding.Entity,
+	},
+	BacklinkSlice: &objectbox.RelationToMany{
+		Id:     5,
+		Source: &EntityBinding.Entity,
+		Target: &TestEntityRelatedBinding.Entity,
+	},
 }
 
 // GeneratorVersion is called by ObjectBox to verify the compatibility of the generator used to generate this code
@@ -1807,10 +2436,13 @@ func (testEntityRelated_EntityInfo) Load(ob *objectbox.ObjectBox, bytes []byte)
 	}
 
 	return &TestEntityRelated{
-		Id:        propId,
-		Name:      fbutils.GetStringSlot(table, 6),
-		Next:      relNext,
-		NextSlice: relNextSlice,
+		Id:            propId,
+		Name:          fbutils.GetStringSlot(table, 6),
+		Next:          relNext,
+		NextSlice:     relNextSlice,
+		BacklinkPtr:   nil, // use TestEntityRelatedBox::FetchBacklinkPtr() to fetch this lazy-loaded backlink,
+		BacklinkSlice: nil, // use TestEntityRelatedBox::FetchBacklinkSlice() to fetch this lazy-loaded backlink,
+
 	}, nil
 }
 
@@ -1839,6 +2471,13 @@ func BoxForTestEntityRelated(ob *objectbox.ObjectBox) *TestEntityRelatedBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *TestEntityRelatedBox) WithTx(tx *objectbox.Tx) *TestEntityRelatedBox {
+	return &TestEntityRelatedBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the TestEntityRelated.Id property on the passed object will be assigned the new ID as well.
@@ -1879,6 +2518,12 @@ func (box *TestEntityRelatedBox) PutMany(objects []*TestEntityRelated) ([]uint64
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *TestEntityRelatedBox) PutManyContext(ctx context.Context, objects []*TestEntityRelated) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -1920,11 +2565,115 @@ func (box *TestEntityRelatedBox) GetAll() ([]*TestEntityRelated, error) {
 	return objects.([]*TestEntityRelated), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *TestEntityRelatedBox) GetAllContext(ctx context.Context) ([]*TestEntityRelated, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestEntityRelated), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *TestEntityRelatedBox) Iterate() *TestEntityRelatedIterator {
+	return &TestEntityRelatedIterator{box.Box.Iterate()}
+}
+
+// FetchBacklinkPtr reads objects pointing to the given ones using the relation Entity::RelatedPtr.
+// It will "GetManyExisting()" all Entity objects linking to each of the given objects
+// and set object.BacklinkPtr to the slice of linking objects, as currently stored in DB.
+func (box *TestEntityRelatedBox) FetchBacklinkPtr(objects ...*TestEntityRelated) error {
+	var slices = make([][]*Entity, len(objects))
+	err := box.ObjectBox.RunInReadTx(func() error {
+		var sourceBox = BoxForEntity(box.ObjectBox)
+
+		// collect slices before setting the objects' fields
+		// this keeps all the objects untouched in case there's an error during any of the requests
+		for k, object := range objects {
+			rIds, err := sourceBox.BacklinkIds(Entity_.RelatedPtr, object.Id)
+			var sources interface{}
+			if err == nil {
+				sources, err = sourceBox.Box.GetManyExisting(rIds...)
+			}
+			if err != nil {
+				return err
+			}
+
+			// the source entity binding may read objects by value or by pointer, independent of this field
+			switch sources := sources.(type) {
+			case []*Entity:
+				slices[k] = sources
+			case []Entity:
+				slices[k] = make([]*Entity, len(sources))
+				for i := range sources {
+					slices[k][i] = &sources[i]
+				}
+			}
+		}
+		return nil
+	})
+
+	if err == nil { // update the field on all objects if we got all slices
+		for k := range objects {
+			objects[k].BacklinkPtr = slices[k]
+		}
+	}
+	return err
+}
+
+// FetchBacklinkSlice reads objects pointing to the given ones using the relation Entity::RelatedPtrSlice.
+// It will "GetManyExisting()" all Entity objects linking to each of the given objects
+// and set object.BacklinkSlice to the slice of linking objects, as currently stored in DB.
+func (box *TestEntityRelatedBox) FetchBacklinkSlice(objects ...*TestEntityRelated) error {
+	var slices = make([][]*Entity, len(objects))
+	err := box.ObjectBox.RunInReadTx(func() error {
+		var sourceBox = BoxForEntity(box.ObjectBox)
+
+		// collect slices before setting the objects' fields
+		// this keeps all the objects untouched in case there's an error during any of the requests
+		for k, object := range objects {
+			rIds, err := sourceBox.RelationBacklinkIds(Entity_.RelatedPtrSlice, object.Id)
+			var sources interface{}
+			if err == nil {
+				sources, err = sourceBox.Box.GetManyExisting(rIds...)
+			}
+			if err != nil {
+				return err
+			}
+
+			// the source entity binding may read objects by value or by pointer, independent of this field
+			switch sources := sources.(type) {
+			case []*Entity:
+				slices[k] = sources
+			case []Entity:
+				slices[k] = make([]*Entity, len(sources))
+				for i := range sources {
+					slices[k][i] = &sources[i]
+				}
+			}
+		}
+		return nil
+	})
+
+	if err == nil { // update the field on all objects if we got all slices
+		for k := range objects {
+			objects[k].BacklinkSlice = slices[k]
+		}
+	}
+	return err
+}
+
 // Remove deletes a single object
 func (box *TestEntityRelatedBox) Remove(object *TestEntityRelated) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *TestEntityRelatedBox) RemoveContext(ctx context.Context, object *TestEntityRelated) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -1938,6 +2687,16 @@ func (box *TestEntityRelatedBox) RemoveMany(objects ...*TestEntityRelated) (uint
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *TestEntityRelatedBox) RemoveManyContext(ctx context.Context, objects ...*TestEntityRelated) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the TestEntityRelated_ struct to create conditions.
 // Keep the *TestEntityRelatedQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -2020,6 +2779,30 @@ func (asyncBox *TestEntityRelatedAsyncBox) Remove(object *TestEntityRelated) err
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// TestEntityRelatedIterator streams stored objects one by one, see TestEntityRelatedBox.Iterate()
+type TestEntityRelatedIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *TestEntityRelatedIterator) Seek(id uint64) *TestEntityRelatedIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *TestEntityRelatedIterator) Reverse() *TestEntityRelatedIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *TestEntityRelatedIterator) ForEach(fn func(*TestEntityRelated) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*TestEntityRelated))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all TestEntityRelated which Id is either 42 or 47:
@@ -2037,6 +2820,58 @@ func (query *TestEntityRelatedQuery) Find() ([]*TestEntityRelated, error) {
 	return objects.([]*TestEntityRelated), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *TestEntityRelatedQuery) FindContext(ctx context.Context) ([]*TestEntityRelated, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*TestEntityRelated), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *TestEntityRelatedQuery) FindFirst() (*TestEntityRelated, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestEntityRelated), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *TestEntityRelatedQuery) FindUnique() (*TestEntityRelated, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*TestEntityRelated), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *TestEntityRelatedQuery) Page(size uint64, afterToken string) ([]*TestEntityRelated, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*TestEntityRelated), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *TestEntityRelatedQuery) Filter(fn func(*TestEntityRelated) bool) *TestEntityRelatedQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*TestEntityRelated))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *TestEntityRelatedQuery) Offset(offset uint64) *TestEntityRelatedQuery {
 	query.Query.Offset(offset)
@@ -2048,3 +2883,23 @@ func (query *TestEntityRelatedQuery) Limit(limit uint64) *TestEntityRelatedQuery
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *TestEntityRelatedQuery) ForEach(fn func(*TestEntityRelated) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*TestEntityRelated))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *TestEntityRelatedQuery) Subscribe(fn func([]*TestEntityRelated, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*TestEntityRelated), nil)
+		}
+	})
+}
@@ -4,6 +4,7 @@
 package iot
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
@@ -161,6 +162,13 @@ func BoxForEvent(ob *objectbox.ObjectBox) *EventBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *EventBox) WithTx(tx *objectbox.Tx) *EventBox {
+	return &EventBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the Event.Id property on the passed object will be assigned the new ID as well.
@@ -201,6 +209,12 @@ func (box *EventBox) PutMany(objects []*Event) ([]uint64, error) {
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *EventBox) PutManyContext(ctx context.Context, objects []*Event) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -242,11 +256,31 @@ func (box *EventBox) GetAll() ([]*Event, error) {
 	return objects.([]*Event), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *EventBox) GetAllContext(ctx context.Context) ([]*Event, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Event), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *EventBox) Iterate() *EventIterator {
+	return &EventIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *EventBox) Remove(object *Event) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *EventBox) RemoveContext(ctx context.Context, object *Event) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -260,6 +294,16 @@ func (box *EventBox) RemoveMany(objects ...*Event) (uint64, error) {
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *EventBox) RemoveManyContext(ctx context.Context, objects ...*Event) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the Event_ struct to create conditions.
 // Keep the *EventQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -342,6 +386,30 @@ func (asyncBox *EventAsyncBox) Remove(object *Event) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// EventIterator streams stored objects one by one, see EventBox.Iterate()
+type EventIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *EventIterator) Seek(id uint64) *EventIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *EventIterator) Reverse() *EventIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *EventIterator) ForEach(fn func(*Event) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Event))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all Event which Id is either 42 or 47:
@@ -359,6 +427,58 @@ func (query *EventQuery) Find() ([]*Event, error) {
 	return objects.([]*Event), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *EventQuery) FindContext(ctx context.Context) ([]*Event, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Event), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *EventQuery) FindFirst() (*Event, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Event), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *EventQuery) FindUnique() (*Event, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Event), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *EventQuery) Page(size uint64, afterToken string) ([]*Event, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*Event), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *EventQuery) Filter(fn func(*Event) bool) *EventQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*Event))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EventQuery) Offset(offset uint64) *EventQuery {
 	query.Query.Offset(offset)
@@ -371,6 +491,26 @@ func (query *EventQuery) Limit(limit uint64) *EventQuery {
 	return query
 }
 
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *EventQuery) ForEach(fn func(*Event) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*Event))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *EventQuery) Subscribe(fn func([]*Event, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*Event), nil)
+		}
+	})
+}
+
 type reading_EntityInfo struct {
 	objectbox.Entity
 	Uid uint64
@@ -564,6 +704,13 @@ func BoxForReading(ob *objectbox.ObjectBox) *ReadingBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *ReadingBox) WithTx(tx *objectbox.Tx) *ReadingBox {
+	return &ReadingBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the Id is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the Reading.Id property on the passed object will be assigned the new ID as well.
@@ -604,6 +751,12 @@ func (box *ReadingBox) PutMany(objects []*Reading) ([]uint64, error) {
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *ReadingBox) PutManyContext(ctx context.Context, objects []*Reading) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -645,11 +798,31 @@ func (box *ReadingBox) GetAll() ([]*Reading, error) {
 	return objects.([]*Reading), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *ReadingBox) GetAllContext(ctx context.Context) ([]*Reading, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Reading), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *ReadingBox) Iterate() *ReadingIterator {
+	return &ReadingIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *ReadingBox) Remove(object *Reading) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *ReadingBox) RemoveContext(ctx context.Context, object *Reading) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -663,6 +836,16 @@ func (box *ReadingBox) RemoveMany(objects ...*Reading) (uint64, error) {
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *ReadingBox) RemoveManyContext(ctx context.Context, objects ...*Reading) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.Id
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the Reading_ struct to create conditions.
 // Keep the *ReadingQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -745,6 +928,30 @@ func (asyncBox *ReadingAsyncBox) Remove(object *Reading) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// ReadingIterator streams stored objects one by one, see ReadingBox.Iterate()
+type ReadingIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *ReadingIterator) Seek(id uint64) *ReadingIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *ReadingIterator) Reverse() *ReadingIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *ReadingIterator) ForEach(fn func(*Reading) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Reading))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all Reading which Id is either 42 or 47:
@@ -762,6 +969,58 @@ func (query *ReadingQuery) Find() ([]*Reading, error) {
 	return objects.([]*Reading), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *ReadingQuery) FindContext(ctx context.Context) ([]*Reading, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Reading), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *ReadingQuery) FindFirst() (*Reading, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Reading), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *ReadingQuery) FindUnique() (*Reading, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Reading), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *ReadingQuery) Page(size uint64, afterToken string) ([]*Reading, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*Reading), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *ReadingQuery) Filter(fn func(*Reading) bool) *ReadingQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*Reading))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *ReadingQuery) Offset(offset uint64) *ReadingQuery {
 	query.Query.Offset(offset)
@@ -773,3 +1032,23 @@ func (query *ReadingQuery) Limit(limit uint64) *ReadingQuery {
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *ReadingQuery) ForEach(fn func(*Reading) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*Reading))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *ReadingQuery) Subscribe(fn func([]*Reading, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*Reading), nil)
+		}
+	})
+}
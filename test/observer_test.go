@@ -0,0 +1,161 @@
+/*
+ * Copyright 2019 ObjectBox Ltd. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package objectbox_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/objectbox/objectbox-go/objectbox"
+	"github.com/objectbox/objectbox-go/test/assert"
+	"github.com/objectbox/objectbox-go/test/model"
+)
+
+func waitForChange(t *testing.T, observer *objectbox.Observer) []objectbox.TypeId {
+	select {
+	case ids := <-observer.C:
+		return ids
+	case <-time.After(5 * time.Second):
+		assert.Failf(t, "observer notification not received")
+	}
+	return nil
+}
+
+func assertNoChange(t *testing.T, observer *objectbox.Observer) {
+	select {
+	case ids := <-observer.C:
+		assert.Failf(t, "unexpected observer notification %v", ids)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestObserver(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	observer, err := env.Box.Subscribe()
+	assert.NoErr(t, err)
+	defer observer.Close()
+
+	_, err = env.Box.Put(model.Entity47())
+	assert.NoErr(t, err)
+	assert.Eq(t, []objectbox.TypeId{model.EntityBinding.Id}, waitForChange(t, observer))
+
+	// changes of other types are not delivered
+	_, err = model.BoxForTestEntityRelated(env.ObjectBox).Put(&model.TestEntityRelated{Name: "related"})
+	assert.NoErr(t, err)
+	assertNoChange(t, observer)
+
+	// aborted transactions are not delivered either
+	assert.Eq(t, "abort", env.ObjectBox.RunInWriteTx(func() error {
+		if _, err := env.Box.Put(model.Entity47()); err != nil {
+			return err
+		}
+		return errors.New("abort")
+	}).Error())
+	assertNoChange(t, observer)
+
+	// closing is idempotent and closes the channel
+	observer.Close()
+	observer.Close()
+	_, open := <-observer.C
+	assert.Eq(t, false, open)
+}
+
+func TestObserverAllTypes(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var received = make(chan []objectbox.TypeId, 10)
+	observer, err := env.ObjectBox.SubscribeFunc(func(ids []objectbox.TypeId) {
+		received <- ids
+	})
+	assert.NoErr(t, err)
+	defer observer.Close()
+
+	assert.NoErr(t, env.ObjectBox.RunInWriteTx(func() error {
+		if _, err := env.Box.Put(model.Entity47()); err != nil {
+			return err
+		}
+		_, err := model.BoxForTestEntityRelated(env.ObjectBox).Put(&model.TestEntityRelated{Name: "related"})
+		return err
+	}))
+
+	select {
+	case ids := <-received:
+		assert.EqItems(t, []objectbox.TypeId{model.EntityBinding.Id, model.TestEntityRelatedBinding.Id}, ids)
+	case <-time.After(5 * time.Second):
+		assert.Failf(t, "observer notification not received")
+	}
+}
+
+func TestObserverClosedWithStore(t *testing.T) {
+	env := model.NewTestEnv(t)
+
+	observer, err := env.Box.Subscribe()
+	assert.NoErr(t, err)
+
+	env.Close()
+
+	_, open := <-observer.C
+	assert.Eq(t, false, open)
+}
+
+func TestQuerySubscribe(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.PutEntity(&model.Entity{Int32: 1})
+
+	var results = make(chan []*model.Entity, 10)
+	var query = env.Box.Query(model.Entity_.Int32.GreaterThan(0))
+	observer, err := query.Subscribe(func(entities []*model.Entity, err error) {
+		assert.NoErr(t, err)
+		results <- entities
+	})
+	assert.NoErr(t, err)
+	defer observer.Close()
+
+	var waitForResult = func() []*model.Entity {
+		select {
+		case entities := <-results:
+			return entities
+		case <-time.After(5 * time.Second):
+			assert.Failf(t, "query subscription result not received")
+		}
+		return nil
+	}
+
+	// the current result is delivered immediately
+	assert.Eq(t, 1, len(waitForResult()))
+
+	env.PutEntity(&model.Entity{Int32: 2})
+	assert.Eq(t, 2, len(waitForResult()))
+
+	// changes not matching the query still trigger the query to be re-run
+	env.PutEntity(&model.Entity{Int32: -1})
+	assert.Eq(t, 2, len(waitForResult()))
+
+	observer.Close()
+	env.PutEntity(&model.Entity{Int32: 3})
+	select {
+	case entities := <-results:
+		assert.Failf(t, "unexpected query subscription result %v", entities)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
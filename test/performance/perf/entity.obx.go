@@ -4,6 +4,7 @@
 package perf
 
 import (
+	"context"
 	"errors"
 	"github.com/google/flatbuffers/go"
 	"github.com/objectbox/objectbox-go/objectbox"
@@ -157,6 +158,13 @@ func BoxForEntity(ob *objectbox.ObjectBox) *EntityBox {
 	}
 }
 
+// WithTx returns a copy of the box bound to the given transaction, see objectbox.Tx for more details.
+func (box *EntityBox) WithTx(tx *objectbox.Tx) *EntityBox {
+	return &EntityBox{
+		Box: box.Box.WithTx(tx),
+	}
+}
+
 // Put synchronously inserts/updates a single object.
 // In case the ID is not specified, it would be assigned automatically (auto-increment).
 // When inserting, the Entity.ID property on the passed object will be assigned the new ID as well.
@@ -197,6 +205,12 @@ func (box *EntityBox) PutMany(objects []*Entity) ([]uint64, error) {
 	return box.Box.PutMany(objects)
 }
 
+// PutManyContext is like PutMany but stops processing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.PutManyContext() for details.
+func (box *EntityBox) PutManyContext(ctx context.Context, objects []*Entity) ([]uint64, error) {
+	return box.Box.PutManyContext(ctx, objects)
+}
+
 // Get reads a single object.
 //
 // Returns nil (and no error) in case the object with the given ID doesn't exist.
@@ -238,11 +252,31 @@ func (box *EntityBox) GetAll() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// GetAllContext is like GetAll but stops reading when the context is done, returning ctx.Err().
+func (box *EntityBox) GetAllContext(ctx context.Context) ([]*Entity, error) {
+	objects, err := box.Box.GetAllContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Entity), nil
+}
+
+// Iterate creates an iterator going through all stored objects one by one, ordered by their IDs.
+// Use it instead of GetAll() to process large amounts of objects without loading all of them into memory.
+func (box *EntityBox) Iterate() *EntityIterator {
+	return &EntityIterator{box.Box.Iterate()}
+}
+
 // Remove deletes a single object
 func (box *EntityBox) Remove(object *Entity) error {
 	return box.Box.Remove(object)
 }
 
+// RemoveContext is like Remove but doesn't remove the object if the context is already done, returning ctx.Err().
+func (box *EntityBox) RemoveContext(ctx context.Context, object *Entity) error {
+	return box.Box.RemoveContext(ctx, object)
+}
+
 // RemoveMany deletes multiple objects at once.
 // Returns the number of deleted object or error on failure.
 // Note that this method will not fail if an object is not found (e.g. already removed).
@@ -256,6 +290,16 @@ func (box *EntityBox) RemoveMany(objects ...*Entity) (uint64, error) {
 	return box.Box.RemoveIds(ids...)
 }
 
+// RemoveManyContext is like RemoveMany but stops removing the objects when the context is done, returning ctx.Err().
+// See objectbox.Box.RemoveIdsContext() for details.
+func (box *EntityBox) RemoveManyContext(ctx context.Context, objects ...*Entity) (uint64, error) {
+	var ids = make([]uint64, len(objects))
+	for k, object := range objects {
+		ids[k] = object.ID
+	}
+	return box.Box.RemoveIdsContext(ctx, ids...)
+}
+
 // Creates a query with the given conditions. Use the fields of the Entity_ struct to create conditions.
 // Keep the *EntityQuery if you intend to execute the query multiple times.
 // Note: this function panics if you try to create illegal queries; e.g. use properties of an alien type.
@@ -338,6 +382,30 @@ func (asyncBox *EntityAsyncBox) Remove(object *Entity) error {
 	return asyncBox.AsyncBox.Remove(object)
 }
 
+// EntityIterator streams stored objects one by one, see EntityBox.Iterate()
+type EntityIterator struct {
+	*objectbox.BoxIterator
+}
+
+// Seek makes the iteration start at the object with the given ID (or the next one if there's no such object)
+func (iterator *EntityIterator) Seek(id uint64) *EntityIterator {
+	iterator.BoxIterator.Seek(id)
+	return iterator
+}
+
+// Reverse makes the iteration go from the highest ID to the lowest one
+func (iterator *EntityIterator) Reverse() *EntityIterator {
+	iterator.BoxIterator.Reverse()
+	return iterator
+}
+
+// ForEach calls fn for each object until it returns false or an error
+func (iterator *EntityIterator) ForEach(fn func(*Entity) (bool, error)) error {
+	return iterator.BoxIterator.ForEach(func(object interface{}) (bool, error) {
+		return fn(object.(*Entity))
+	})
+}
+
 // Query provides a way to search stored objects
 //
 // For example, you can find all Entity which ID is either 42 or 47:
@@ -355,6 +423,58 @@ func (query *EntityQuery) Find() ([]*Entity, error) {
 	return objects.([]*Entity), nil
 }
 
+// FindContext is like Find but stops reading when the context is done, returning ctx.Err().
+func (query *EntityQuery) FindContext(ctx context.Context) ([]*Entity, error) {
+	objects, err := query.Query.FindContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return objects.([]*Entity), nil
+}
+
+// FindFirst returns the first object matching the query, respecting the order and the offset, or nil if there's none
+func (query *EntityQuery) FindFirst() (*Entity, error) {
+	object, err := query.Query.FindFirst()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Entity), nil
+}
+
+// FindUnique returns the single object matching the query, or nil if there's none.
+// Returns objectbox.ErrNonUniqueResult if more than one object matches.
+func (query *EntityQuery) FindUnique() (*Entity, error) {
+	object, err := query.Query.FindUnique()
+	if err != nil || object == nil {
+		return nil, err
+	}
+	return object.(*Entity), nil
+}
+
+// Page returns at most size objects following the object the afterToken was issued for, and a token for the next page.
+// Pass an empty afterToken to get the first page; the returned token is empty when there are no more objects.
+// See objectbox.Query.Page() for details.
+func (query *EntityQuery) Page(size uint64, afterToken string) ([]*Entity, string, error) {
+	objects, nextToken, err := query.Query.Page(size, afterToken)
+	if err != nil {
+		return nil, "", err
+	}
+	return objects.([]*Entity), nextToken, nil
+}
+
+// Filter sets a function deciding whether an object matching the query conditions is part of the result.
+// Offset and Limit are applied to the filtered objects. See objectbox.Query.Filter() for details.
+func (query *EntityQuery) Filter(fn func(*Entity) bool) *EntityQuery {
+	if fn == nil {
+		query.Query.Filter(nil)
+	} else {
+		query.Query.Filter(func(object interface{}) bool {
+			return fn(object.(*Entity))
+		})
+	}
+	return query
+}
+
 // Offset defines the index of the first object to process (how many objects to skip)
 func (query *EntityQuery) Offset(offset uint64) *EntityQuery {
 	query.Query.Offset(offset)
@@ -366,3 +486,23 @@ func (query *EntityQuery) Limit(limit uint64) *EntityQuery {
 	query.Query.Limit(limit)
 	return query
 }
+
+// ForEach calls fn for each object matching the query, one by one, until it returns false.
+// As opposed to Find(), the objects are not collected in a slice, making it suitable for large result sets.
+func (query *EntityQuery) ForEach(fn func(*Entity) bool) error {
+	return query.Query.ForEach(func(object interface{}) bool {
+		return fn(object.(*Entity))
+	})
+}
+
+// Subscribe calls fn with the current query result and then again after each committed transaction changing
+// the queried objects. The function is called on a separate goroutine. Close the returned observer to unsubscribe.
+func (query *EntityQuery) Subscribe(fn func([]*Entity, error)) (*objectbox.Observer, error) {
+	return query.Query.Subscribe(func(objects interface{}, err error) {
+		if err != nil {
+			fn(nil, err)
+		} else {
+			fn(objects.([]*Entity), nil)
+		}
+	})
+}
@@ -17,15 +17,19 @@
 package objectbox_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/test/assert"
 	"github.com/objectbox/objectbox-go/test/model"
+	"github.com/objectbox/objectbox-go/test/model/iot"
+	"math"
 	"reflect"
 	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -218,15 +222,294 @@ func TestQueryOffsetLimit(t *testing.T) {
 	env := model.NewTestEnv(t)
 	defer env.Close()
 
-	testQueries(t, env, queryTestOptions{baseCount: 10, skipCount: true, skipRemove: true}, []queryTestCase{
+	testQueries(t, env, queryTestOptions{baseCount: 10, skipRemove: true}, []queryTestCase{
 		{10, s{`TRUE`}, env.Box.Query(), nil},
 		{5, s{`TRUE`}, env.Box.Query().Offset(5), nil},
 		{3, s{`TRUE`}, env.Box.Query().Limit(3), nil},
 		{3, s{`TRUE`}, env.Box.Query().Offset(3).Limit(3), nil},
 		{1, s{`TRUE`}, env.Box.Query().Offset(9).Limit(3), nil},
+		{0, s{`TRUE`}, env.Box.Query().Offset(10), nil},
 	})
 }
 
+func TestQueryCountMax(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(2))
+
+	var assertCountMax = func(expected uint64, max uint64) {
+		count, err := query.CountMax(max)
+		assert.NoErr(t, err)
+		assert.Eq(t, expected, count)
+	}
+
+	assertCountMax(8, 0)
+	assertCountMax(5, 5)
+	assertCountMax(8, 100)
+
+	query.Offset(6)
+	assertCountMax(2, 5)
+	assertCountMax(2, 0)
+
+	query.Offset(1).Limit(3)
+	assertCountMax(2, 2)
+	assertCountMax(3, 5)
+	assertCountMax(3, 0)
+}
+
+func TestQueryNot(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box
+	var E = model.Entity_
+	var coef = float64(4) // computed the same way as in the test entities to get exactly the same float value
+
+	testQueries(t, env, queryTestOptions{baseCount: 10}, []queryTestCase{
+		{9, nil, box.Query(objectbox.Not(E.Int32.Equals(47))), nil},
+		{1, nil, box.Query(objectbox.Not(objectbox.Not(E.Int32.Equals(47)))), nil},
+		{5, nil, box.Query(objectbox.Not(E.Int32.GreaterThan(47 * 5))), nil},
+		{6, nil, box.Query(objectbox.Not(E.Int32.LessThan(47 * 5))), nil},
+		{7, nil, box.Query(objectbox.Not(E.Int32.Between(47*2, 47*4))), nil},
+		{8, nil, box.Query(objectbox.Not(E.Int32.In(47, 94))), nil},
+		{2, nil, box.Query(objectbox.Not(E.Int32.NotIn(47, 94))), nil},
+		{9, nil, box.Query(objectbox.Not(E.String.Equals("Val-1", true))), nil},
+		{8, nil, box.Query(objectbox.Not(E.String.In(true, "Val-1", "val-2"))), nil},
+		{5, nil, box.Query(objectbox.Not(E.Bool.Equals(true))), nil},
+		{4, nil, box.Query(objectbox.Not(E.Float64.GreaterThan(47.74 * coef))), nil},
+		{8, nil, box.Query(objectbox.None(E.Int32.Equals(47), E.Int32.Equals(94))), nil},
+		{2, nil, box.Query(objectbox.Not(objectbox.All(E.Int32.GreaterThan(47), E.Int32.LessThan(470)))), nil},
+		{4, nil, box.Query(objectbox.Not(objectbox.Any(E.Bool.Equals(true), E.Int32.Equals(94)))), nil},
+		{5, nil, box.Query(objectbox.Xor(E.Bool.Equals(true), E.Int32.LessThan(47*5))), nil},
+		{8, nil, box.Query(E.Int32.GreaterThan(47), objectbox.Not(E.Int32.Equals(94))), nil},
+	})
+
+	_, err := box.QueryOrError(objectbox.Not(E.String.Contains("Val", true)))
+	assert.Err(t, err)
+
+	_, err = box.QueryOrError(objectbox.Not(E.Related.Link(model.TestEntityRelated_.Name.Equals("", true))))
+	assert.Err(t, err)
+
+	_, err = box.QueryOrError(objectbox.Not(E.Int32.Equals(47)).Alias("alias"))
+	assert.Err(t, err)
+}
+
+func TestQueryNotParams(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box
+	var E = model.Entity_
+
+	env.Populate(10)
+
+	var assertCount = func(query *model.EntityQuery, expected uint64) {
+		count, err := query.Count()
+		assert.NoErr(t, err)
+		assert.Eq(t, expected, count)
+	}
+
+	// a condition with a single complement can be parametrized both by property and by alias
+	var query = box.Query(objectbox.Not(E.Int32.Equals(47)))
+	assertCount(query, 9)
+	assert.NoErr(t, query.SetInt64Params(E.Int32, 47*10))
+	assertCount(query, 9)
+	assert.NoErr(t, query.SetInt64Params(E.Int32, 1))
+	assertCount(query, 10)
+
+	query = box.Query(objectbox.Not(E.Int32.Equals(47).Alias("a")))
+	assert.NoErr(t, query.SetInt64Params(objectbox.Alias("a"), 94))
+	assertCount(query, 9)
+
+	// a condition replaced by multiple ones (a < x OR a == x) can't be parametrized
+	_, err := box.QueryOrError(objectbox.Not(E.Int32.GreaterThan(47 * 5).Alias("a")))
+	assert.Err(t, err)
+
+	query = box.Query(objectbox.Not(E.Int32.GreaterThan(47 * 5)))
+	assertCount(query, 5)
+	assert.Err(t, query.SetInt64Params(E.Int32, 47*6))
+	assertCount(query, 5)
+
+	// other properties are not affected
+	query = box.Query(objectbox.Not(E.Int32.GreaterThan(47*5)), E.Int64.GreaterThan(0))
+	assert.NoErr(t, query.SetInt64Params(E.Int64, 0))
+}
+
+func TestQueryString(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box.Box
+
+	var qs = func(text string) *model.EntityQuery {
+		query, err := box.QueryString(text)
+		assert.NoErr(t, err)
+		return &model.EntityQuery{Query: query}
+	}
+
+	type i = interface{}
+	var eq = func(q interface{}) *objectbox.Query { return q.(*objectbox.Query) }
+
+	testQueries(t, env, queryTestOptions{baseCount: 10}, []queryTestCase{
+		{1, nil, qs("Int32 = 47"), nil},
+		{1, nil, qs("int32 == 47"), nil},
+		{9, nil, qs("Int32 != 47"), nil},
+		{5, nil, qs("Int32 > 235"), nil},
+		{6, nil, qs("Int32 >= 235"), nil},
+		{4, nil, qs("Int32 < 235"), nil},
+		{5, nil, qs("Int32 <= 235"), nil},
+		{3, nil, qs("Int32 BETWEEN 94 AND 188"), nil},
+		{2, nil, qs("Int32 IN (47, 94)"), nil},
+		{2, nil, qs("Int64 in (47, 94)"), nil},
+		{2, nil, qs("Int16 IN (47, 94)"), nil},
+		{8, nil, qs("Int32 NOT IN (47, 94)"), nil},
+		{5, nil, qs("Bool = true"), nil},
+		{4, nil, qs("Int32 > 94 AND NOT Bool = true"), nil},
+		{5, nil, qs("Int32 > 94 and not (Bool = true or Int32 = 188) OR Int32 = 47 OR Int32 = 94"), nil},
+		{1, nil, qs(`String = 'Val-1'`), nil},
+		{0, nil, qs(`String = "val-1"`), nil},
+		{5, nil, qs("String STARTSWITH 'Val'"), nil},
+		{2, nil, qs("String ENDSWITH '1' OR String CONTAINS '-2'"), nil},
+		{2, nil, qs("String IN ('Val-1', 'val-2')"), nil},
+		{1, nil, qs("StringVector HAS 'first-5'"), nil},
+		{3, nil, qs("Float64 > 335 AND Float64 < 478"), nil},
+		{2, nil, qs("Float64 BETWEEN 47 AND 96"), nil},
+		{10, nil, qs("String IS NOT NULL AND ByteVector IS NOT NULL"), nil},
+		{0, nil, qs("String IS NULL"), nil},
+		{10, nil, qs("ORDER BY Int32 DESC"), nil},
+		{1, nil, qs("Int32 = $x"), func(q i) error { return eq(q).SetInt64Params(objectbox.Alias("x"), 94) }},
+		{2, nil, qs("String STARTSWITH $prefix AND Int32 < $max"), func(q i) error {
+			if err := eq(q).SetStringParams(objectbox.Alias("prefix"), "val"); err != nil {
+				return err
+			}
+			return eq(q).SetInt64Params(objectbox.Alias("max"), 47*5)
+		}},
+		{1, nil, qs("StringVector HAS $tag"), func(q i) error { return eq(q).SetStringParams(objectbox.Alias("tag"), "first-5") }},
+	})
+
+	// ORDER BY
+	env.Populate(10)
+	ids, err := qs("Int32 < 235 ORDER BY Bool ASC, Int32 DESC").FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, 4, len(ids))
+
+	objects, err := qs("Int32 < 235 ORDER BY Int32 DESC").Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, int32(188), objects[0].Int32)
+	assert.Eq(t, int32(47), objects[3].Int32)
+
+	// invalid queries
+	for _, text := range []string{
+		"",
+		"Int32",
+		"Int32 =",
+		"Int32 = 'a'",
+		"Int32 = 1 AND",
+		"(Int32 = 1",
+		"Int32 = 1)",
+		"Unknown = 1",
+		"Int32 ~ 1",
+		"Int32 CONTAINS 1",
+		"String = 'unterminated",
+		"String CONTAINS 1",
+		"Int32 BETWEEN $a AND $b",
+		"Int32 >= $a",
+		"NOT Int32 = $a",
+		"String NOT CONTAINS 'a'",
+		"NOT String STARTSWITH 'a'",
+		"NOT (Int32 = 1 OR String ENDSWITH 'a')",
+		"StringVector NOT HAS 'a'",
+		"Int32 ! 1",
+		"Int32 !< 1",
+		"ByteVector = 1",
+		"Int32 = 1 ORDER Int32",
+		"Int32 = 1 ORDER BY",
+	} {
+		_, err := box.QueryString(text)
+		if err == nil {
+			assert.Failf(t, "expected an error for query string %q", text)
+		}
+	}
+}
+
+func TestQueryFilterSpec(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box.Box
+
+	var qf = func(text string) *model.EntityQuery {
+		filter, err := objectbox.ParseFilterSpec([]byte(text))
+		assert.NoErr(t, err)
+		query, err := box.QueryFilterSpec(filter)
+		assert.NoErr(t, err)
+		return &model.EntityQuery{Query: query}
+	}
+
+	testQueries(t, env, queryTestOptions{baseCount: 10}, []queryTestCase{
+		{10, nil, qf(`{}`), nil},
+		{1, nil, qf(`{"prop": "Int32", "op": "eq", "value": 47}`), nil},
+		{6, nil, qf(`{"prop": "Int32", "op": "gte", "value": 235}`), nil},
+		{3, nil, qf(`{"prop": "Int32", "op": "between", "value": [94, 188]}`), nil},
+		{2, nil, qf(`{"prop": "Int64", "op": "in", "value": [47, 94]}`), nil},
+		{5, nil, qf(`{"prop": "Bool", "op": "eq", "value": true}`), nil},
+		{5, nil, qf(`{"prop": "String", "op": "startsWith", "value": "Val"}`), nil},
+		{1, nil, qf(`{"prop": "StringVector", "op": "has", "value": "first-5"}`), nil},
+		{3, nil, qf(`{"prop": "Float64", "op": "between", "value": [95, 200]}`), nil},
+		{4, nil, qf(`{"all": [{"prop": "Int32", "op": "gt", "value": 94}, {"not": {"prop": "Bool", "op": "eq", "value": true}}]}`), nil},
+		{3, nil, qf(`{"any": [{"prop": "Int32", "op": "lt", "value": 95}, {"prop": "String", "op": "in", "value": ["val-10"]}]}`), nil},
+		{10, nil, qf(`{"prop": "String", "op": "notNull"}`), nil},
+	})
+
+	// round-trip
+	var filter = &objectbox.FilterSpec{All: []*objectbox.FilterSpec{
+		{Prop: "Int32", Op: "gt", Value: 94},
+		{Any: []*objectbox.FilterSpec{{Prop: "Bool", Op: "eq", Value: false}, {Prop: "Uint64", Op: "in", Value: []uint64{47}}}},
+	}}
+	parsed, err := objectbox.ParseFilterSpec([]byte(filter.String()))
+	assert.NoErr(t, err)
+	assert.Eq(t, filter.String(), parsed.String())
+
+	env.Populate(10)
+	query, err := box.QueryFilterSpec(parsed)
+	assert.NoErr(t, err)
+	count, err := query.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(4), count)
+
+	// invalid filters
+	for _, text := range []string{
+		`{"prop": "Unknown", "op": "eq", "value": 1}`,
+		`{"prop": "Int32", "op": "unknown", "value": 1}`,
+		`{"prop": "Int32", "op": "eq"}`,
+		`{"prop": "Int32", "op": "eq", "value": "1"}`,
+		`{"prop": "Int32", "op": "eq", "value": 1.5}`,
+		`{"prop": "Int32", "op": "eq", "value": [1]}`,
+		`{"prop": "Int32", "op": "between", "value": [1]}`,
+		`{"prop": "Int32", "op": "in", "value": 1}`,
+		`{"prop": "Int32", "op": "in", "value": []}`,
+		`{"prop": "String", "op": "in", "value": []}`,
+		`{"prop": "Int32", "op": "contains", "value": 1}`,
+		`{"prop": "Int32", "op": "isNull", "value": 1}`,
+		`{"prop": "String", "op": "eq", "value": 1}`,
+		`{"prop": "Int32", "op": "eq", "value": 1, "all": [{"prop": "Int32", "op": "eq", "value": 1}]}`,
+		`{"all": []}`,
+		`{"not": {}}`,
+	} {
+		filter, err := objectbox.ParseFilterSpec([]byte(text))
+		assert.NoErr(t, err)
+		if _, err := box.QueryFilterSpec(filter); err == nil {
+			assert.Failf(t, "expected an error for filter %s", text)
+		}
+	}
+
+	_, err = objectbox.ParseFilterSpec([]byte(`{"prop": "Int32", "unknown": 1}`))
+	assert.Err(t, err)
+}
+
 func TestQueryParams(t *testing.T) {
 	env := model.NewTestEnv(t)
 	defer env.Close()
@@ -597,6 +880,10 @@ func TestQueryLinks(t *testing.T) {
 		{1, s{`TRUE Link: String == "Val-1"`}, boxR.Query(E.Related.Link(E.String.Equals("", true))),
 			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
 
+		// to-one explicit backlink, using the helper generated for the backlink field
+		{1, s{`TRUE Link: String == "Val-1"`}, boxR.Query(R.BacklinkPtr.Backlink(E.String.Equals("", true))),
+			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
+
 		// to-one empty
 		{10, s{`TRUE Link: TRUE`}, box.Query(E.Related.Link()), nil},
 		{10, s{`TRUE Link: TRUE`}, boxR.Query(E.Related.Link()), nil},
@@ -609,6 +896,10 @@ func TestQueryLinks(t *testing.T) {
 		{1, s{`TRUE Link: String == "Val-1"`}, boxR.Query(E.RelatedPtrSlice.Link(E.String.Equals("", true))),
 			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
 
+		// to-many explicit backlink, using the helper generated for the backlink field
+		{1, s{`TRUE Link: String == "Val-1"`}, boxR.Query(R.BacklinkSlice.Backlink(E.String.Equals("", true))),
+			func(q i) error { return eq(q).SetStringParams(E.String, e.String) }},
+
 		// to-many empty
 		{10, s{`TRUE Link: TRUE`}, box.Query(E.RelatedPtrSlice.Link()), nil},
 		{10, s{`TRUE Link: TRUE`}, boxR.Query(E.RelatedPtrSlice.Link()), nil},
@@ -641,6 +932,13 @@ func TestQueryLinks(t *testing.T) {
 		), nil},
 	})
 
+	// explicit backlink can only be used on the relation target entity
+	func() {
+		defer assert.MustPanic(t, regexp.MustCompile("backlink target entity [0-9]+ doesn't match the queried entity"))
+
+		box.Query(E.RelatedPtr.Backlink(E.String.Equals("Val-1", true)))
+	}()
+
 	// ALL (explicit, inner): two to-one links and a source-entity condition
 	func() {
 		defer assert.MustPanic(t, regexp.MustCompile("using Link inside Any/All is not supported"))
@@ -665,6 +963,81 @@ func TestQueryLinks(t *testing.T) {
 	}()
 }
 
+func TestQueryVectorConditions(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var box = env.Box
+	var E = model.Entity_
+
+	var count = func(query *model.EntityQuery) uint64 {
+		count, err := query.Count()
+		assert.NoErr(t, err)
+		return count
+	}
+
+	// entity47() sets StringVector to {"first-i", "second-i", ""} and ByteVector to {i, 2i, 3i, 5i, 8i}
+	assert.Eq(t, uint64(2), count(box.Query(E.StringVector.ContainsAny([]string{"first-1", "second-3", "x"}, true))))
+	assert.Eq(t, uint64(2), count(box.Query(E.StringVector.ContainsAny([]string{"FIRST-1", "SECOND-3"}, false))))
+	assert.Eq(t, uint64(1), count(box.Query(E.StringVector.ContainsAll([]string{"first-2", "second-2"}, true))))
+	assert.Eq(t, uint64(0), count(box.Query(E.StringVector.ContainsAll([]string{"first-2", "second-3"}, true))))
+
+	// an empty list matches no objects for "any" and all objects for "all"
+	assert.Eq(t, uint64(0), count(box.Query(E.StringVector.ContainsAny(nil, true))))
+	assert.Eq(t, uint64(10), count(box.Query(E.StringVector.ContainsAll(nil, true))))
+	assert.Eq(t, uint64(1), count(box.Query(objectbox.Any(E.StringVector.ContainsAny([]string{}, true), E.Int32.Equals(47)))))
+	assert.Eq(t, uint64(1), count(box.Query(E.StringVector.ContainsAll([]string{}, true), E.Int32.Equals(47))))
+
+	assert.Eq(t, uint64(2), count(box.Query(E.StringVector.ContainsPrefix("first-1", true))))
+	assert.Eq(t, uint64(2), count(box.Query(E.StringVector.ContainsPrefix("FIRST-1", false))))
+	assert.Eq(t, uint64(0), count(box.Query(E.StringVector.ContainsPrefix("FIRST-1", true))))
+	assert.Eq(t, uint64(8), count(box.Query(objectbox.Not(E.StringVector.ContainsPrefix("first-1", true)))))
+	assert.Eq(t, uint64(1), count(box.Query(E.StringVector.ContainsPrefix("first-1", true), E.Bool.Equals(false))))
+
+	assert.Eq(t, uint64(10), count(box.Query(E.StringVector.LengthEquals(3))))
+	assert.Eq(t, uint64(10), count(box.Query(E.StringVector.LengthGreaterThan(2))))
+	assert.Eq(t, uint64(0), count(box.Query(E.StringVector.LengthLessThan(3))))
+	assert.Eq(t, uint64(10), count(box.Query(E.ByteVector.LengthEquals(5))))
+
+	assert.Eq(t, uint64(1), count(box.Query(E.ByteVector.HasPrefix([]byte{2}))))
+	assert.Eq(t, uint64(1), count(box.Query(E.ByteVector.HasPrefix([]byte{1, 2}))))
+	assert.Eq(t, uint64(0), count(box.Query(E.ByteVector.HasPrefix([]byte{1, 3}))))
+	assert.Eq(t, uint64(0), count(box.Query(E.ByteVector.HasPrefix([]byte{0xFF}))))
+	assert.Eq(t, uint64(10), count(box.Query(E.ByteVector.HasPrefix(nil))))
+	assert.Eq(t, uint64(9), count(box.Query(objectbox.Not(E.ByteVector.HasPrefix([]byte{2})))))
+
+	// offset and limit apply to the objects matching the conditions evaluated in Go
+	ids, err := box.Query(E.StringVector.ContainsPrefix("first-", true)).Offset(2).Limit(3).FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 4, 5}, ids)
+
+	// nil vectors are empty
+	assert.Eq(t, uint64(0), count(box.Query(E.StringVector.IsEmpty())))
+	_, err = box.Put(&model.Entity{})
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count(box.Query(E.StringVector.IsEmpty())))
+	assert.Eq(t, uint64(1), count(box.Query(E.ByteVector.IsEmpty())))
+	assert.Eq(t, uint64(10), count(box.Query(objectbox.Not(E.StringVector.IsEmpty()))))
+	assert.Eq(t, uint64(10), count(box.Query(objectbox.Not(E.ByteVector.IsEmpty()))))
+
+	_, err = box.Put(&model.Entity{StringVector: []string{}, ByteVector: []byte{}})
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count(box.Query(E.StringVector.IsEmpty())))
+	assert.Eq(t, uint64(2), count(box.Query(E.ByteVector.IsEmpty())))
+
+	// ByteVector.IsEmpty() is a native condition so it can be combined using OR
+	assert.Eq(t, uint64(3), count(box.Query(objectbox.Any(E.ByteVector.IsEmpty(), E.Int32.Equals(47)))))
+
+	// conditions evaluated in Go can't be combined using OR
+	_, err = box.QueryOrError(objectbox.Any(E.StringVector.IsEmpty(), E.Int32.Equals(47)))
+	assert.Err(t, err)
+
+	_, err = box.Query(E.StringVector.IsEmpty()).Property(E.Int32).Sum()
+	assert.Err(t, err)
+}
+
 func TestQueryOrderSimple(t *testing.T) {
 	env := model.NewTestEnv(t)
 	defer env.Close()
@@ -756,6 +1129,75 @@ func TestQueryOrder(t *testing.T) {
 	})
 }
 
+func TestQueryOrderBy(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var box = env.Box
+	var E = model.Entity_
+
+	var ids = func(query *model.EntityQuery) []uint64 {
+		ids, err := query.FindIds()
+		assert.NoErr(t, err)
+		return ids
+	}
+
+	// the precedence follows the order of the first use of each property
+	assert.Eq(t, []uint64{10, 8, 6, 4, 2, 9, 7, 5, 3, 1},
+		ids(box.Query(objectbox.OrderBy(E.Bool), objectbox.OrderBy(E.Int32, objectbox.OrderDescending))))
+	assert.Eq(t, []uint64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		ids(box.Query(objectbox.OrderBy(E.Int32, objectbox.OrderDescending), objectbox.OrderBy(E.Bool))))
+
+	// using OrderBy() again replaces the flags but keeps the precedence
+	assert.Eq(t, []uint64{2, 4, 6, 8, 10, 1, 3, 5, 7, 9},
+		ids(box.Query(objectbox.OrderBy(E.Bool), E.Int32.OrderDesc(), objectbox.OrderBy(E.Int32))))
+
+	// types without Order*() methods
+	assert.Eq(t, []uint64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+		ids(box.Query(objectbox.OrderBy(E.ByteVector, objectbox.OrderDescending))))
+	assert.Eq(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		ids(box.Query(objectbox.OrderBy(E.Float64))))
+
+	_, err := box.QueryOrError(objectbox.OrderBy(E.StringVector))
+	assert.Err(t, err)
+
+	_, err = box.QueryOrError(objectbox.OrderBy(objectbox.Alias("alias")))
+	assert.Err(t, err)
+
+	// changing the order of an existing query keeps the changed parameters
+	var query = box.Query(E.Int32.GreaterThan(0), objectbox.OrderBy(E.Int32))
+	assert.NoErr(t, query.SetInt64Params(E.Int32, 47*5))
+	assert.Eq(t, []uint64{6, 7, 8, 9, 10}, ids(query))
+
+	assert.NoErr(t, query.SetOrderParams(E.Int32, objectbox.OrderDescending))
+	assert.Eq(t, []uint64{10, 9, 8, 7, 6}, ids(query))
+
+	assert.NoErr(t, query.SetOrderParams(E.Int32))
+	assert.Eq(t, []uint64{6, 7, 8, 9, 10}, ids(query))
+
+	assert.Err(t, query.SetOrderParams(E.Bool, objectbox.OrderDescending))
+}
+
+func TestQueryOrderByUnsigned(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	for _, value := range []uint64{1, math.MaxUint64, math.MaxInt64 + 1, 2} {
+		_, err := env.Box.Put(&model.Entity{Uint64: value})
+		assert.NoErr(t, err)
+	}
+
+	ids, err := env.Box.Query(objectbox.OrderBy(model.Entity_.Uint64)).FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{1, 4, 3, 2}, ids)
+
+	ids, err = env.Box.Query(model.Entity_.Uint64.OrderDesc()).FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{2, 3, 4, 1}, ids)
+}
+
 func TestQueryClose(t *testing.T) {
 	env := model.NewTestEnv(t)
 	defer env.Close()
@@ -1176,3 +1618,511 @@ func matchAllEntityIds(t *testing.T, ids []uint64, items interface{}) {
 
 	assert.EqItems(t, ids, actualIds)
 }
+
+func TestQueryProperty(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.PutEntity(&model.Entity{Int64: 1, Int32: 10, Float64: 1.5, String: "a"})
+	env.PutEntity(&model.Entity{Int64: 2, Int32: 10, Float64: 2.5, String: "A"})
+	env.PutEntity(&model.Entity{Int64: 6, Int32: 20, Float64: 3, String: "b"})
+	env.PutEntity(&model.Entity{Int64: 100, Int32: 30, Float64: 4, String: "c"})
+
+	var query = env.Box.Query(model.Entity_.Int64.LessThan(100))
+	var pq = query.Property(model.Entity_.Int64)
+
+	sum, err := pq.Sum()
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(9), sum)
+
+	min, err := pq.Min()
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(1), min)
+
+	max, err := pq.Max()
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(6), max)
+
+	avg, err := pq.Average()
+	assert.NoErr(t, err)
+	assert.Eq(t, float64(3), avg)
+
+	int64s, err := pq.FindInt64s()
+	assert.NoErr(t, err)
+	assert.EqItems(t, []int64{1, 2, 6}, int64s)
+
+	floatSum, err := query.Property(model.Entity_.Float64).SumFloat64()
+	assert.NoErr(t, err)
+	assert.Eq(t, float64(7), floatSum)
+
+	int32s, err := query.Property(model.Entity_.Int32).Distinct(true).FindInt32s()
+	assert.NoErr(t, err)
+	assert.EqItems(t, []int32{10, 20}, int32s)
+
+	count, err := query.Property(model.Entity_.Int32).Count(false)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	count, err = query.Property(model.Entity_.Int32).Count(true)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	strs, err := query.Property(model.Entity_.String).FindStrings(true, false)
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(strs))
+
+	strs, err = query.Property(model.Entity_.String).FindStrings(true, true)
+	assert.NoErr(t, err)
+	assert.EqItems(t, []string{"a", "A", "b"}, strs)
+
+	// properties of other entities are rejected
+	_, err = query.Property(model.TestEntityRelated_.Name).FindStrings(false, false)
+	assert.Err(t, err)
+
+	// as well as offset & limit
+	_, err = query.Limit(1).Property(model.Entity_.Int64).Sum()
+	assert.Err(t, err)
+}
+
+func TestQueryPropertyTyped(t *testing.T) {
+	env := model.NewTestEnv(t)
+	defer env.Close()
+
+	env.PutEntity(&model.Entity{Int64: 1, Int32: 10, Uint8: 1, Float32: 1.5, String: "a"})
+	env.PutEntity(&model.Entity{Int64: 2, Int32: 10, Uint8: 2, Float32: 2.5, String: "A"})
+	env.PutEntity(&model.Entity{Int64: 6, Int32: 20, Uint8: 3, Float32: 3, String: "b"})
+	env.PutEntity(&model.Entity{Int64: 100, Int32: 30, Uint8: 4, Float32: 4, String: "c"})
+
+	var E = model.Entity_
+	var query = env.Box.Query(E.Int64.LessThan(100)).Query
+
+	sum, err := E.Int64.Query(query).Sum()
+	assert.NoErr(t, err)
+	assert.Eq(t, int64(9), sum)
+
+	int32s, err := E.Int32.Query(query).Distinct(true).Find()
+	assert.NoErr(t, err)
+	assert.EqItems(t, []int32{10, 20}, int32s)
+
+	maxInt32, err := E.Int32.Query(query).Max()
+	assert.NoErr(t, err)
+	assert.Eq(t, int32(20), maxInt32)
+
+	minUint8, err := E.Uint8.Query(query).Min()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint8(1), minUint8)
+
+	count, err := E.Int32.Query(query).Count(true)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	floatSum, err := E.Float32.Query(query).Sum()
+	assert.NoErr(t, err)
+	assert.Eq(t, float64(7), floatSum)
+
+	maxFloat32, err := E.Float32.Query(query).Max()
+	assert.NoErr(t, err)
+	assert.Eq(t, float32(3), maxFloat32)
+
+	strs, err := E.String.Query(query).Find(true, true)
+	assert.NoErr(t, err)
+	assert.EqItems(t, []string{"a", "A", "b"}, strs)
+
+	// the same checks as for untyped property queries apply
+	_, err = model.TestEntityRelated_.Name.Query(query).Find(false, false)
+	assert.Err(t, err)
+}
+
+func TestQueryDescribeAndPlan(t *testing.T) {
+	env := iot.NewTestEnv()
+	defer env.Close()
+
+	var box = iot.BoxForEvent(env.ObjectBox)
+
+	var query = box.Query(iot.Event_.Uid.Equals("foo", true), iot.Event_.Device.HasPrefix("dev", true))
+	desc, err := query.Describe()
+	assert.NoErr(t, err)
+	assert.True(t, strings.Contains(desc, "Event"))
+
+	var plan = query.Plan()
+	assert.Eq(t, false, plan.FullScan)
+	assert.Eq(t, 2, len(plan.Conditions))
+	assert.Eq(t, objectbox.QueryPlanCondition{
+		EntityId:   iot.EventBinding.Id,
+		PropertyId: iot.Event_.Uid.Id,
+		Property:   "Uid",
+		Operation:  "StringEquals",
+		Indexed:    true,
+		UsesIndex:  true,
+	}, plan.Conditions[0])
+	assert.Eq(t, "Device", plan.Conditions[1].Property)
+	assert.Eq(t, false, plan.Conditions[1].Indexed)
+	assert.Eq(t, false, plan.Conditions[1].UsesIndex)
+	assert.Eq(t, "estimated index lookup [StringEquals(Uid):index, StringHasPrefix(Device):scan]", plan.String())
+
+	// an index can't serve case insensitive or range conditions, nor conditions combined with OR
+	assert.Eq(t, true, box.Query(iot.Event_.Uid.Equals("foo", false)).Plan().FullScan)
+	assert.Eq(t, true, box.Query(iot.Event_.Uid.GreaterThan("foo", true)).Plan().FullScan)
+	assert.Eq(t, true, box.Query(objectbox.Any(iot.Event_.Uid.Equals("foo", true), iot.Event_.Device.Equals("bar", true))).Plan().FullScan)
+	assert.Eq(t, true, box.Query(objectbox.Not(iot.Event_.Uid.Equals("foo", true))).Plan().FullScan)
+
+	// an index can serve an AND with at least one indexed condition or an OR with all of them indexed
+	assert.Eq(t, false, box.Query(objectbox.All(iot.Event_.Id.Equals(1), objectbox.Not(iot.Event_.Date.GreaterThan(5)))).Plan().FullScan)
+	assert.Eq(t, false, box.Query(iot.Event_.Id.Equals(1), objectbox.Any(iot.Event_.Date.GreaterThan(5), iot.Event_.Date.LessThan(1))).Plan().FullScan)
+	assert.Eq(t, false, box.Query(objectbox.Any(iot.Event_.Uid.Equals("foo", true), iot.Event_.Id.In(1, 2))).Plan().FullScan)
+	assert.Eq(t, true, box.Query(objectbox.Any(objectbox.All(iot.Event_.Id.Equals(1), iot.Event_.Date.GreaterThan(5)), iot.Event_.Date.LessThan(1))).Plan().FullScan)
+
+	// the ID property is always indexed
+	plan = box.Query(iot.Event_.Id.In(1, 2)).Plan()
+	assert.Eq(t, false, plan.FullScan)
+	assert.Eq(t, true, plan.Conditions[0].UsesIndex)
+}
+
+func TestQueryClone(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.Equals(0))
+	clone, err := query.Clone()
+	assert.NoErr(t, err)
+
+	assert.NoErr(t, clone.SetInt64Params(model.Entity_.Id, 3))
+	ids, err := clone.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3}, ids)
+
+	// the original query is not affected by the changed params
+	count, err := query.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(0), count)
+
+	assert.NoErr(t, query.Close())
+	_, err = query.Clone()
+	assert.Err(t, err)
+
+	// the clone is independent of the original query
+	count, err = clone.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+}
+
+func TestQueryPool(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var prototype = env.Box.Query(model.Entity_.Id.Equals(0)).Query
+	var pool = objectbox.NewQueryPool(prototype)
+
+	var wg sync.WaitGroup
+	var errs = make(chan error, 100)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(id uint64) {
+			defer wg.Done()
+
+			query, err := pool.Get()
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer pool.Put(query)
+
+			if err = query.SetInt64Params(model.Entity_.Id, int64(id)); err != nil {
+				errs <- err
+				return
+			}
+
+			if ids, err := query.FindIds(); err != nil {
+				errs <- err
+			} else if len(ids) != 1 || ids[0] != id {
+				errs <- fmt.Errorf("expected [%d], got %v", id, ids)
+			}
+		}(uint64(i%10 + 1))
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoErr(t, err)
+	}
+
+	// closing the pool closes the prototype and the pooled queries, a query returned later is closed by Put()
+	query, err := pool.Get()
+	assert.NoErr(t, err)
+	assert.NoErr(t, pool.Close())
+	_, err = prototype.Count()
+	assert.Err(t, err)
+	_, err = pool.Get()
+	assert.Err(t, err)
+
+	pool.Put(query)
+	_, err = query.Count()
+	assert.Err(t, err)
+
+	assert.NoErr(t, pool.Close())
+}
+
+func TestQueryForEach(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(2)).Offset(1).Limit(5)
+
+	var ids []uint64
+	assert.NoErr(t, query.ForEach(func(object *model.Entity) bool {
+		ids = append(ids, object.Id)
+		return true
+	}))
+	assert.Eq(t, []uint64{4, 5, 6, 7, 8}, ids)
+
+	// early termination
+	ids = nil
+	assert.NoErr(t, query.ForEach(func(object *model.Entity) bool {
+		ids = append(ids, object.Id)
+		return len(ids) < 2
+	}))
+	assert.Eq(t, []uint64{4, 5}, ids)
+}
+
+func TestQueryFindFirstAndUnique(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box
+	var E = model.Entity_
+
+	object, err := box.Query().FindFirst()
+	assert.NoErr(t, err)
+	assert.True(t, object == nil)
+
+	object, err = box.Query().FindUnique()
+	assert.NoErr(t, err)
+	assert.True(t, object == nil)
+
+	env.Populate(10)
+
+	object, err = box.Query(E.Int32.GreaterThan(47)).FindFirst()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), object.Id)
+
+	object, err = box.Query(E.Int32.GreaterThan(47), E.Int32.OrderDesc()).FindFirst()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(10), object.Id)
+
+	object, err = box.Query(E.Int32.GreaterThan(47)).Offset(3).FindFirst()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(5), object.Id)
+
+	object, err = box.Query(E.String.Equals("Val-3", true)).FindUnique()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), object.Id)
+
+	object, err = box.Query(E.String.Equals("Val-2", true)).FindUnique()
+	assert.NoErr(t, err)
+	assert.True(t, object == nil)
+
+	object, err = box.Query(E.Bool.Equals(true)).FindUnique()
+	assert.Eq(t, objectbox.ErrNonUniqueResult, err)
+	assert.True(t, object == nil)
+
+	// only the objects within the offset and limit are considered
+	object, err = box.Query(E.Bool.Equals(true)).Offset(4).FindUnique()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(9), object.Id)
+}
+
+func TestQueryPage(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box
+	var E = model.Entity_
+
+	env.Populate(10)
+
+	var readPages = func(query *model.EntityQuery, size uint64) [][]uint64 {
+		var pages [][]uint64
+		var token string
+		for {
+			objects, nextToken, err := query.Page(size, token)
+			assert.NoErr(t, err)
+
+			var ids = []uint64{}
+			for _, object := range objects {
+				ids = append(ids, object.Id)
+			}
+			pages = append(pages, ids)
+
+			if nextToken == "" {
+				return pages
+			}
+			token = nextToken
+		}
+	}
+
+	assert.Eq(t, [][]uint64{{1, 2, 3, 4}, {5, 6, 7, 8}, {9, 10}}, readPages(box.Query(), 4))
+	assert.Eq(t, [][]uint64{{3, 4, 5, 6, 7}, {8, 9, 10}}, readPages(box.Query(E.Int32.GreaterThan(94)), 5))
+	assert.Eq(t, [][]uint64{{10, 9, 8}, {7, 6, 5}, {4, 3, 2}, {1}}, readPages(box.Query(E.Int32.OrderDesc()), 3))
+	assert.Eq(t, [][]uint64{{1, 3, 5}, {7, 9, 2}, {4, 6, 8}, {10}}, readPages(box.Query(E.Bool.OrderDesc()), 3))
+	assert.Eq(t, [][]uint64{{10, 8}, {6, 4}, {2, 9}, {7, 5}, {3, 1}, {}},
+		readPages(box.Query(E.Bool.OrderAsc(), E.Float64.OrderDesc()), 2))
+	assert.Eq(t, [][]uint64{{8, 6, 4, 2, 10}, {9, 7, 5, 3, 1}, {}}, readPages(box.Query(E.String.OrderDesc(true)), 5))
+
+	// parameters changed after creating the query apply to all pages, including QueryString placeholders
+	var paramQuery = box.Query(E.Int32.GreaterThan(0).Alias("min"))
+	assert.NoErr(t, paramQuery.SetInt64Params(objectbox.Alias("min"), 47*6))
+	assert.Eq(t, [][]uint64{{7, 8}, {9, 10}, {}}, readPages(paramQuery, 2))
+
+	stringQuery, err := box.QueryString("Int32 > $min ORDER BY Int32 DESC")
+	assert.NoErr(t, err)
+	assert.NoErr(t, stringQuery.SetInt64Params(objectbox.Alias("min"), 47*7))
+	assert.Eq(t, [][]uint64{{10, 9, 8}, {}}, readPages(&model.EntityQuery{Query: stringQuery}, 3))
+
+	// changes between reading the pages don't shift the following pages
+	var query = box.Query(E.Int32.OrderAsc())
+	objects, token, err := query.Page(3, "")
+	assert.NoErr(t, err)
+	assert.Eq(t, 3, len(objects))
+	assert.NoErr(t, box.RemoveId(1))
+	assert.NoErr(t, box.RemoveId(5))
+	objects, _, err = query.Page(3, token)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{4, 6, 7}, []uint64{objects[0].Id, objects[1].Id, objects[2].Id})
+
+	// the token is only valid for queries with the same order
+	_, _, err = box.Query(E.Int32.OrderDesc()).Page(3, token)
+	assert.Err(t, err)
+
+	_, _, err = query.Page(3, "invalid")
+	assert.Err(t, err)
+
+	_, _, err = query.Page(0, "")
+	assert.Err(t, err)
+}
+
+func TestQueryFilterFunc(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	var box = env.Box
+	var E = model.Entity_
+
+	env.Populate(10)
+
+	var ids = func(objects []*model.Entity) []uint64 {
+		var result = []uint64{}
+		for _, object := range objects {
+			result = append(result, object.Id)
+		}
+		return result
+	}
+
+	var query = box.Query().Filter(func(object *model.Entity) bool {
+		return object.Int32%3 == 0
+	})
+
+	objects, err := query.Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 6, 9}, ids(objects))
+
+	objects, err = box.Query(E.Bool.Equals(true)).Filter(func(object *model.Entity) bool {
+		return object.Int32%3 == 0
+	}).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 9}, ids(objects))
+
+	foundIds, err := query.FindIds()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 6, 9}, foundIds)
+
+	count, err := query.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	count, err = query.CountMax(2)
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(2), count)
+
+	object, err := query.FindFirst()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), object.Id)
+
+	_, err = query.FindUnique()
+	assert.Eq(t, objectbox.ErrNonUniqueResult, err)
+
+	// offset and limit are applied after filtering
+	objects, err = query.Offset(1).Limit(1).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{6}, ids(objects))
+
+	count, err = query.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	objects, err = query.Offset(2).Limit(0).Find()
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{9}, ids(objects))
+
+	query.Offset(0)
+	objects, token, err := query.Page(2, "")
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{3, 6}, ids(objects))
+	objects, token, err = query.Page(2, token)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{9}, ids(objects))
+	assert.Eq(t, "", token)
+
+	count, err = query.Remove()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(3), count)
+
+	count, err = box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(7), count)
+
+	// removing the filter
+	count, err = query.Filter(nil).Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(7), count)
+}
+
+func TestQueryStream(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+
+	env.Populate(10)
+
+	var query = env.Box.Query(model.Entity_.Id.GreaterThan(2)).Limit(5)
+
+	var ids []uint64
+	for result := range query.Stream(context.Background()) {
+		assert.NoErr(t, result.Err)
+		ids = append(ids, result.Object.(*model.Entity).Id)
+	}
+	assert.Eq(t, []uint64{3, 4, 5, 6, 7}, ids)
+
+	// stop early by cancelling the context - the channel must be closed afterwards
+	ctx, cancel := context.WithCancel(context.Background())
+	var results = query.Stream(ctx)
+	var first = <-results
+	assert.NoErr(t, first.Err)
+	assert.Eq(t, uint64(3), first.Object.(*model.Entity).Id)
+	cancel()
+	for range results {
+	}
+
+	// errors are sent as the last result
+	assert.NoErr(t, query.Close())
+	var count int
+	for result := range query.Stream(context.Background()) {
+		assert.Err(t, result.Err)
+		count++
+	}
+	assert.Eq(t, 1, count)
+}
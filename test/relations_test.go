@@ -258,3 +258,62 @@ func TestRelationsMissing(t *testing.T) {
 	assert.True(t, 0 == len(read.RelatedSlice))
 	assert.True(t, nil == read.RelatedPtrSlice)
 }
+
+func TestRelationsBacklinks(t *testing.T) {
+	var env = model.NewTestEnv(t)
+	defer env.Close()
+	var relBox = model.BoxForTestEntityRelated(env.ObjectBox)
+
+	var rel1 = &model.TestEntityRelated{Name: "1", NextSlice: []model.EntityByValue{}}
+	var rel2 = &model.TestEntityRelated{Name: "2", NextSlice: []model.EntityByValue{}}
+	var objects = []*model.Entity{
+		{RelatedPtr: rel1, RelatedPtrSlice: []*model.TestEntityRelated{rel1, rel2}},
+		{RelatedPtr: rel1, RelatedPtrSlice: []*model.TestEntityRelated{rel2}},
+		{RelatedPtr: rel2, RelatedPtrSlice: []*model.TestEntityRelated{}},
+	}
+	_, err := env.Box.PutMany(objects)
+	assert.NoErr(t, err)
+
+	ids, err := env.Box.BacklinkIds(model.Entity_.RelatedPtr, rel1.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{objects[0].Id, objects[1].Id}, ids)
+
+	ids, err = env.Box.BacklinkIds(model.Entity_.RelatedPtr2, rel1.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, 0, len(ids))
+
+	ids, err = env.Box.RelationBacklinkIds(model.Entity_.RelatedPtrSlice, rel2.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{objects[0].Id, objects[1].Id}, ids)
+
+	// the same works when called on the target box
+	ids, err = relBox.RelationBacklinkIds(model.Entity_.RelatedPtrSlice, rel1.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, []uint64{objects[0].Id}, ids)
+
+	// backlinks are not loaded automatically
+	rels, err := relBox.GetMany(rel1.Id, rel2.Id)
+	assert.NoErr(t, err)
+	assert.True(t, rels[0].BacklinkPtr == nil)
+	assert.True(t, rels[0].BacklinkSlice == nil)
+
+	assert.NoErr(t, relBox.FetchBacklinkPtr(rels...))
+	assert.Eq(t, 2, len(rels[0].BacklinkPtr))
+	assert.Eq(t, objects[0].Id, rels[0].BacklinkPtr[0].Id)
+	assert.Eq(t, objects[1].Id, rels[0].BacklinkPtr[1].Id)
+	assert.Eq(t, 1, len(rels[1].BacklinkPtr))
+	assert.Eq(t, objects[2].Id, rels[1].BacklinkPtr[0].Id)
+
+	assert.NoErr(t, relBox.FetchBacklinkSlice(rels...))
+	assert.Eq(t, 1, len(rels[0].BacklinkSlice))
+	assert.Eq(t, objects[0].Id, rels[0].BacklinkSlice[0].Id)
+	assert.Eq(t, 2, len(rels[1].BacklinkSlice))
+
+	// backlinks are read-only, i.e. putting the target doesn't change the relations
+	rels[0].BacklinkPtr = nil
+	_, err = relBox.Put(rels[0])
+	assert.NoErr(t, err)
+	ids, err = env.Box.BacklinkIds(model.Entity_.RelatedPtr, rel1.Id)
+	assert.NoErr(t, err)
+	assert.Eq(t, 2, len(ids))
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/objectbox/objectbox-go/objectbox"
 	"github.com/objectbox/objectbox-go/test/assert"
 	"github.com/objectbox/objectbox-go/test/model/iot"
 )
@@ -93,3 +94,104 @@ func TestTransactionRollback(t *testing.T) {
 	assert.Eq(t, 0, int(count))
 
 }
+
+func TestTransactionExplicit(t *testing.T) {
+	env := iot.NewTestEnv()
+	defer env.Close()
+
+	var box = iot.BoxForEvent(env.ObjectBox)
+
+	// committed
+	tx, err := env.ObjectBox.Begin(false)
+	assert.NoErr(t, err)
+	assert.Eq(t, false, tx.IsReadOnly())
+
+	var txBox = box.WithTx(tx)
+	_, err = txBox.Put(&iot.Event{Device: "committed"})
+	assert.NoErr(t, err)
+	assert.NoErr(t, tx.Commit())
+	assert.Eq(t, false, tx.IsActive())
+	assert.NoErr(t, tx.Abort()) // no-op after commit
+
+	// the box is unusable after the transaction has finished
+	_, err = txBox.Put(&iot.Event{})
+	assert.Err(t, err)
+	_, err = txBox.Count()
+	assert.Err(t, err)
+	err = tx.Commit()
+	obxErr, isObxErr := err.(*objectbox.Error)
+	assert.True(t, isObxErr)
+	assert.True(t, obxErr.Is(objectbox.ErrIllegalState))
+
+	count, err := box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	// aborted
+	tx, err = env.ObjectBox.Begin(false)
+	assert.NoErr(t, err)
+	_, err = tx.Box(iot.EventBinding.Id).Put(&iot.Event{Device: "aborted"})
+	assert.NoErr(t, err)
+	assert.NoErr(t, box.WithTx(tx).RemoveAll())
+	assert.NoErr(t, tx.Abort())
+
+	count, err = box.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+
+	// read-only
+	tx, err = env.ObjectBox.Begin(true)
+	assert.NoErr(t, err)
+	assert.Eq(t, true, tx.IsReadOnly())
+	events, err := box.WithTx(tx).GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(events))
+	assert.Eq(t, "committed", events[0].Device)
+	_, err = box.WithTx(tx).Put(&iot.Event{})
+	assert.Err(t, err)
+	assert.NoErr(t, tx.Commit())
+}
+
+func TestTransactionExplicitOtherGoroutine(t *testing.T) {
+	env := iot.NewTestEnv()
+	defer env.Close()
+
+	var box = iot.BoxForEvent(env.ObjectBox)
+
+	tx, err := env.ObjectBox.Begin(false)
+	assert.NoErr(t, err)
+	defer tx.Abort()
+
+	var txBox = tx.Box(iot.EventBinding.Id)
+	_, err = txBox.Put(&iot.Event{Device: "same goroutine"})
+	assert.NoErr(t, err)
+
+	// neither the box bound to the transaction nor the transaction itself can be used on another goroutine
+	var errs = make(chan error, 3)
+	go func() {
+		_, err := txBox.Put(&iot.Event{Device: "other goroutine"})
+		errs <- err
+		_, err = txBox.Count()
+		errs <- err
+		errs <- tx.Commit()
+	}()
+
+	for i := 0; i < 3; i++ {
+		err := <-errs
+		obxErr, isObxErr := err.(*objectbox.Error)
+		assert.True(t, isObxErr)
+		assert.True(t, obxErr.Is(objectbox.ErrIllegalState))
+	}
+
+	// the transaction is still usable on the original goroutine
+	assert.True(t, tx.IsActive())
+	count, err := txBox.Count()
+	assert.NoErr(t, err)
+	assert.Eq(t, uint64(1), count)
+	assert.NoErr(t, tx.Commit())
+
+	events, err := box.GetAll()
+	assert.NoErr(t, err)
+	assert.Eq(t, 1, len(events))
+	assert.Eq(t, "same goroutine", events[0].Device)
+}